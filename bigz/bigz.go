@@ -0,0 +1,104 @@
+package bigz
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"math/big"
+	"regexp"
+)
+
+// Number is the constraint for *big.Int, *big.Rat and *big.Float, which compare to numbers of their own type.
+type Number[N any] interface {
+	*big.Int | *big.Rat | *big.Float
+	Cmp(y N) int
+}
+
+var (
+	ErrNil                  = errors.New("big number is nil")
+	ErrEquals               = errors.New("big number does not equal to expected value")
+	ErrNotEqual             = errors.New("big number equals unexpected value")
+	ErrInRange              = errors.New("big number is not in range")
+	ErrGreaterThan          = errors.New("big number is not greater than expected value")
+	ErrLessThan             = errors.New("big number is not less than expected value")
+	ErrGreaterThanOrEqualTo = errors.New("big number is less than expected value")
+	ErrLessThanOrEqualTo    = errors.New("big number is greater than expected value")
+	ErrParse                = errors.New("string is not a decimal number")
+)
+
+// Codes of the check.Error returned by the check.Step in this package.
+const (
+	CodeNil                  = "big.nil"
+	CodeEquals               = "big.equals"
+	CodeNotEqual             = "big.not_equal"
+	CodeInRange              = "big.in_range"
+	CodeGreaterThan          = "big.greater_than"
+	CodeLessThan             = "big.less_than"
+	CodeGreaterThanOrEqualTo = "big.greater_than_or_equal_to"
+	CodeLessThanOrEqualTo    = "big.less_than_or_equal_to"
+	CodeParse                = "big.parse"
+)
+
+var (
+	integerPattern = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	decimalPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+)
+
+// Parse returns a check.Step that parses the string target as a decimal number of type N, and applies the steps
+// to the parsed number like check.ThatOf, or returns ErrParse if the target cannot be parsed. *big.Int only accepts
+// integers, i.e. "-42", while *big.Rat and *big.Float also accept a fraction, i.e. "0.05".
+//
+// *big.Int and *big.Rat are parsed exactly. *big.Float is parsed with enough precision to keep every digit, but a
+// decimal fraction like 0.1 has no exact binary representation, so prefer *big.Rat for exact decimal bounds.
+//
+//	check.That(amount, bigz.Parse(bigz.Of[*big.Rat]().Positive))
+func Parse[N Number[N]](steps ...check.StepOf[N]) check.Step {
+	return check.StepOf[string](func(target string) error {
+		n, ok := parse[N](target)
+		if !ok {
+			return check.NewError(ErrParse, CodeParse, target, nil)
+		}
+		return check.ThatOf(n, steps...)()
+	}).Step()
+}
+
+func parse[N Number[N]](s string) (N, bool) {
+	var (
+		n  N
+		ok bool
+	)
+	switch any(n).(type) {
+	case *big.Int:
+		if integerPattern.MatchString(s) {
+			var i *big.Int
+			i, ok = new(big.Int).SetString(s, 10)
+			n = any(i).(N)
+		}
+	case *big.Rat:
+		if decimalPattern.MatchString(s) {
+			var r *big.Rat
+			r, ok = new(big.Rat).SetString(s)
+			n = any(r).(N)
+		}
+	case *big.Float:
+		if decimalPattern.MatchString(s) {
+			// Each decimal digit needs less than 4 bits.
+			prec := uint(len(s))*4 + 64
+			f, _, err := big.ParseFloat(s, 10, prec, big.ToNearestEven)
+			n, ok = any(f).(N), err == nil
+		}
+	}
+	return n, ok
+}
+
+// zero returns a new N of the value 0.
+func zero[N Number[N]]() N {
+	var n N
+	switch any(n).(type) {
+	case *big.Int:
+		return any(new(big.Int)).(N)
+	case *big.Rat:
+		return any(new(big.Rat)).(N)
+	default:
+		return any(new(big.Float)).(N)
+	}
+}
@@ -0,0 +1,57 @@
+package bigz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/bigz"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	max, _ := new(big.Int).SetString("99999999999999999999", 10)
+	cents, _ := new(big.Rat).SetString("0.01")
+
+	cases := []struct {
+		name   string
+		target interface{}
+		step   check.Step
+		err    error
+	}{
+		{name: "int", target: "99999999999999999999", step: bigz.Parse(bigz.Of[*big.Int]().LessThanOrEqualTo(max))},
+		{name: "int out of bound", target: "100000000000000000000", step: bigz.Parse(bigz.Of[*big.Int]().LessThanOrEqualTo(max)), err: bigz.ErrLessThanOrEqualTo},
+		{name: "int negative", target: "-1", step: bigz.Parse(bigz.Of[*big.Int]().Negative)},
+		{name: "int fraction", target: "1.5", step: bigz.Parse[*big.Int](), err: bigz.ErrParse},
+		{name: "int hex", target: "0x10", step: bigz.Parse[*big.Int](), err: bigz.ErrParse},
+		{name: "rat", target: "0.01", step: bigz.Parse(bigz.Of[*big.Rat]().Equals(cents))},
+		{name: "rat exact", target: "0.010000000000000000000000001", step: bigz.Parse(bigz.Of[*big.Rat]().Equals(cents)), err: bigz.ErrEquals},
+		{name: "rat ratio", target: "1/3", step: bigz.Parse[*big.Rat](), err: bigz.ErrParse},
+		{name: "rat exponent", target: "1e3", step: bigz.Parse[*big.Rat](), err: bigz.ErrParse},
+		{name: "float", target: "12345678901234567890.5", step: bigz.Parse(bigz.Of[*big.Float]().GreaterThan(big.NewFloat(1e19)))},
+		{name: "empty", target: "", step: bigz.Parse[*big.Float](), err: bigz.ErrParse},
+		{name: "not string", target: 1, step: bigz.Parse[*big.Int](), err: check.ErrUnexpectedType},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestParse_Float(t *testing.T) {
+	var parsed *big.Float
+	err := check.That("12345678901234567890.5", bigz.Parse(func(target *big.Float) error {
+		parsed = target
+		return nil
+	}))()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901234567890.5", parsed.Text('f', 1))
+}
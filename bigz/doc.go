@@ -0,0 +1,12 @@
+// Package bigz contains check.StepOf implementation for the arbitrary-precision numbers of math/big, that is,
+// *big.Int, *big.Rat and *big.Float, with the same comparators as int64z. Comparisons are exact, so token amounts
+// and identifiers overflowing int64 can be validated without conversion.
+//
+//	check.ThatOf(supply, bigz.Of[*big.Int]().Positive)
+//
+// Numbers sent as text are validated with Parse, which parses the decimal string into the big number first.
+//
+//	check.That("123456789012345678901234567890", bigz.Parse(bigz.Of[*big.Int]().LessThan(max)))
+//
+// A nil target fails every check.StepOf with ErrNil.
+package bigz
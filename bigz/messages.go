@@ -0,0 +1,21 @@
+package bigz
+
+import "github.com/imulab/check/catalog"
+
+// Messages holds the English message templates of the big number codes. The package registers it into catalog
+// on init.
+var Messages = map[string]string{
+	CodeNil:                  "is required",
+	CodeEquals:               "must equal {expected}",
+	CodeNotEqual:             "must not equal {unexpected}",
+	CodeInRange:              "must be at least {start} and less than {end}",
+	CodeGreaterThan:          "must be greater than {bound}",
+	CodeLessThan:             "must be less than {bound}",
+	CodeGreaterThanOrEqualTo: "must be greater than or equal to {bound}",
+	CodeLessThanOrEqualTo:    "must be less than or equal to {bound}",
+	CodeParse:                "must be a decimal number",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
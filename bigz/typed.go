@@ -0,0 +1,144 @@
+package bigz
+
+import (
+	"github.com/imulab/check"
+	"math/big"
+)
+
+// Of returns the namespace for all check.StepOf which assumes the target is the big number N, one of *big.Int,
+// *big.Rat and *big.Float.
+//
+//	max, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+//	check.ThatOf(id, bigz.Of[*big.Int]().LessThanOrEqualTo(max))
+func Of[N Number[N]]() Typed[N] {
+	t := Typed[N]{}
+	t.Zero = t.Equals(zero[N]())
+	t.Positive = t.GreaterThan(zero[N]())
+	t.Negative = t.LessThan(zero[N]())
+	t.NonPositive = t.LessThanOrEqualTo(zero[N]())
+	t.NonNegative = t.GreaterThanOrEqualTo(zero[N]())
+	return t
+}
+
+// Typed is the namespace for check.StepOf with respect to the big number N. Use Of to obtain an instance. The
+// bounds given to the check.StepOf must not be nil, and must not be modified afterwards.
+//
+// Bounds are reported in the Params of *check.Error as is, except *big.Rat, which is reported as an exact decimal
+// string like "0.05" when possible, and as a fraction like "1/3" otherwise.
+type Typed[N Number[N]] struct {
+	// Zero is a convenient check.StepOf to check equality to 0
+	Zero check.StepOf[N]
+	// Positive is a convenient check.StepOf to check greater than 0
+	Positive check.StepOf[N]
+	// Negative is a convenient check.StepOf to check less than 0
+	Negative check.StepOf[N]
+	// NonPositive is a convenient check.StepOf to check less than or equal to 0
+	NonPositive check.StepOf[N]
+	// NonNegative is a convenient check.StepOf to check greater than or equal to 0
+	NonNegative check.StepOf[N]
+}
+
+// Equals returns a check.StepOf that checks the target equals the expected value, or returns ErrEquals.
+func (Typed[N]) Equals(expected N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(expected) == 0
+	}, ErrEquals, CodeEquals, check.Params{"expected": paramOf(expected)})
+}
+
+// NotEqual returns a check.StepOf that checks the target does not equal the value, or returns ErrNotEqual.
+func (Typed[N]) NotEqual(unexpected N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(unexpected) != 0
+	}, ErrNotEqual, CodeNotEqual, check.Params{"unexpected": paramOf(unexpected)})
+}
+
+// InRange returns a check.StepOf that checks the target is in the range of an inclusive start value and an
+// exclusive end value, like int64z.InRange, or returns ErrInRange.
+func (Typed[N]) InRange(startInclusive N, endExclusive N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(startInclusive) >= 0 && target.Cmp(endExclusive) < 0
+	}, ErrInRange, CodeInRange, check.Params{"start": paramOf(startInclusive), "end": paramOf(endExclusive)})
+}
+
+// GreaterThan returns a check.StepOf that checks the target is greater than the bound, or returns ErrGreaterThan.
+func (Typed[N]) GreaterThan(bound N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(bound) > 0
+	}, ErrGreaterThan, CodeGreaterThan, check.Params{"bound": paramOf(bound)})
+}
+
+// LessThan returns a check.StepOf that checks the target is less than the bound, or returns ErrLessThan.
+func (Typed[N]) LessThan(bound N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(bound) < 0
+	}, ErrLessThan, CodeLessThan, check.Params{"bound": paramOf(bound)})
+}
+
+// GreaterThanOrEqualTo returns a check.StepOf that checks the target is greater than or equal to the bound, or
+// returns ErrGreaterThanOrEqualTo.
+func (Typed[N]) GreaterThanOrEqualTo(bound N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(bound) >= 0
+	}, ErrGreaterThanOrEqualTo, CodeGreaterThanOrEqualTo, check.Params{"bound": paramOf(bound)})
+}
+
+// LessThanOrEqualTo returns a check.StepOf that checks the target is less than or equal to the bound, or returns
+// ErrLessThanOrEqualTo.
+func (Typed[N]) LessThanOrEqualTo(bound N) check.StepOf[N] {
+	return compare(func(target N) bool {
+		return target.Cmp(bound) <= 0
+	}, ErrLessThanOrEqualTo, CodeLessThanOrEqualTo, check.Params{"bound": paramOf(bound)})
+}
+
+// compare returns a check.StepOf which reports ErrNil for a nil target, and the sentinel error when ok is false.
+func compare[N Number[N]](ok func(target N) bool, sentinel error, code string, params check.Params) check.StepOf[N] {
+	return func(target N) error {
+		var none N
+		switch {
+		case target == none:
+			return check.NewError(ErrNil, CodeNil, target, nil)
+		case ok(target):
+			return nil
+		default:
+			return check.NewError(sentinel, code, target, params)
+		}
+	}
+}
+
+// paramOf returns the value to report in check.Params. *big.Rat is reported as a decimal string, i.e. "0.05", if it
+// has a finite decimal representation, or as a fraction, i.e. "1/3", otherwise. Other numbers are reported as is.
+func paramOf[N Number[N]](n N) interface{} {
+	r, ok := any(n).(*big.Rat)
+	if !ok || r == nil {
+		return n
+	}
+	if places, ok := decimalPlaces(r.Denom()); ok {
+		return r.FloatString(places)
+	}
+	return r.RatString()
+}
+
+// decimalPlaces returns the number of decimal places needed to represent 1/denom exactly, which is the larger of
+// the exponents of 2 and 5 in denom. It returns false if denom has any other prime factor.
+func decimalPlaces(denom *big.Int) (int, bool) {
+	var (
+		d      = new(big.Int).Set(denom)
+		q, m   = new(big.Int), new(big.Int)
+		places int
+	)
+	for _, factor := range []int64{2, 5} {
+		f, exp := big.NewInt(factor), 0
+		for {
+			q.QuoRem(d, f, m)
+			if m.Sign() != 0 {
+				break
+			}
+			d.Set(q)
+			exp++
+		}
+		if exp > places {
+			places = exp
+		}
+	}
+	return places, d.IsInt64() && d.Int64() == 1
+}
@@ -0,0 +1,84 @@
+package bigz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/bigz"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestOf(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	third := big.NewRat(1, 3)
+	half := big.NewFloat(0.5)
+
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "int equals", run: check.ThatOf(new(big.Int).Set(huge), bigz.Of[*big.Int]().Equals(huge))},
+		{name: "int not equals", err: bigz.ErrEquals, run: check.ThatOf(big.NewInt(1), bigz.Of[*big.Int]().Equals(huge))},
+		{name: "int greater than", run: check.ThatOf(new(big.Int).Add(huge, big.NewInt(1)), bigz.Of[*big.Int]().GreaterThan(huge))},
+		{name: "int not less than", err: bigz.ErrLessThan, run: check.ThatOf(huge, bigz.Of[*big.Int]().LessThan(huge))},
+		{name: "int positive", run: check.ThatOf(huge, bigz.Of[*big.Int]().Positive)},
+		{name: "int zero", run: check.ThatOf(new(big.Int), bigz.Of[*big.Int]().Zero)},
+		{name: "int nil", err: bigz.ErrNil, run: check.ThatOf[*big.Int](nil, bigz.Of[*big.Int]().NonNegative)},
+		{name: "rat in range", run: check.ThatOf(third, bigz.Of[*big.Rat]().InRange(big.NewRat(0, 1), big.NewRat(1, 2)))},
+		{name: "rat not in range", err: bigz.ErrInRange, run: check.ThatOf(big.NewRat(1, 2), bigz.Of[*big.Rat]().InRange(third, big.NewRat(1, 2)))},
+		{name: "rat not equal", run: check.ThatOf(third, bigz.Of[*big.Rat]().NotEqual(big.NewRat(333, 1000)))},
+		{name: "rat negative", err: bigz.ErrLessThan, run: check.ThatOf(third, bigz.Of[*big.Rat]().Negative)},
+		{name: "float lte", run: check.ThatOf(half, bigz.Of[*big.Float]().LessThanOrEqualTo(big.NewFloat(0.5)))},
+		{name: "float not gte", err: bigz.ErrGreaterThanOrEqualTo, run: check.ThatOf(half, bigz.Of[*big.Float]().GreaterThanOrEqualTo(big.NewFloat(1)))},
+		{name: "float non positive", err: bigz.ErrLessThanOrEqualTo, run: check.ThatOf(half, bigz.Of[*big.Float]().NonPositive)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.run()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestOf_Params(t *testing.T) {
+	err := check.ThatOf(big.NewInt(7), bigz.Of[*big.Int]().InRange(big.NewInt(1), big.NewInt(5)))()
+
+	var checkErr *check.Error
+	if assert.True(t, errors.As(err, &checkErr)) {
+		assert.Equal(t, bigz.CodeInRange, checkErr.Code)
+		assert.Equal(t, "1", checkErr.Params["start"].(*big.Int).String())
+		assert.Equal(t, "5", checkErr.Params["end"].(*big.Int).String())
+	}
+}
+
+func TestOf_RatParams(t *testing.T) {
+	cases := []struct {
+		bound  *big.Rat
+		expect string
+	}{
+		{bound: big.NewRat(1, 20), expect: "0.05"},
+		{bound: big.NewRat(-5, 4), expect: "-1.25"},
+		{bound: big.NewRat(3, 1), expect: "3"},
+		{bound: big.NewRat(1, 1024), expect: "0.0009765625"},
+		{bound: big.NewRat(1, 3), expect: "1/3"},
+		{bound: big.NewRat(7, 60), expect: "7/60"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expect, func(t *testing.T) {
+			err := check.ThatOf(big.NewRat(-100, 1), bigz.Of[*big.Rat]().GreaterThan(c.bound))()
+
+			var checkErr *check.Error
+			if assert.True(t, errors.As(err, &checkErr)) {
+				assert.Equal(t, c.expect, checkErr.Params["bound"])
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package catalog
+
+import (
+	"fmt"
+	"github.com/imulab/check"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Translator translates the message of a code for the locale, with the parameters of the error. It returns false
+// if the message is not available.
+type Translator interface {
+	Translate(locale string, code string, params check.Params) (string, bool)
+}
+
+// TranslatorFunc is a function that implements Translator.
+type TranslatorFunc func(locale string, code string, params check.Params) (string, bool)
+
+// Translate calls the function.
+func (f TranslatorFunc) Translate(locale string, code string, params check.Params) (string, bool) {
+	return f(locale, code, params)
+}
+
+// Catalog is a Translator backed by message templates keyed by locale and code. Locales are case-insensitive, and
+// "_" is equivalent to "-". A Catalog is safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	messages map[string]map[string]string
+}
+
+// New creates an empty Catalog.
+func New() *Catalog {
+	return &Catalog{messages: map[string]map[string]string{}}
+}
+
+// Set sets the message template of the code for the locale, replacing any existing one.
+func (c *Catalog) Set(locale string, code string, template string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	locale = normalize(locale)
+	if c.messages[locale] == nil {
+		c.messages[locale] = map[string]string{}
+	}
+	c.messages[locale][code] = template
+}
+
+// Add sets all message templates, keyed by code, for the locale.
+func (c *Catalog) Add(locale string, templates map[string]string) {
+	for code, template := range templates {
+		c.Set(locale, code, template)
+	}
+}
+
+// Translate renders the message template of the code for the locale with the parameters.
+func (c *Catalog) Translate(locale string, code string, params check.Params) (string, bool) {
+	c.mu.RLock()
+	template, ok := c.messages[normalize(locale)][code]
+	c.mu.RUnlock()
+
+	if !ok {
+		return "", false
+	}
+	return Format(template, params), true
+}
+
+// Format replaces the parameter references in the template, i.e. "{start}", with the parameter values. Slices are
+// formatted as comma separated values. References to absent parameters are left as is.
+func Format(template string, params check.Params) string {
+	var sb strings.Builder
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+
+		sb.WriteString(template[:start])
+		if v, ok := params[template[start+1:end]]; ok {
+			sb.WriteString(formatValue(v))
+		} else {
+			sb.WriteString(template[start : end+1])
+		}
+		template = template[end+1:]
+	}
+	sb.WriteString(template)
+	return sb.String()
+}
+
+func formatValue(v interface{}) string {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+		values := make([]string, rv.Len())
+		for i := range values {
+			values[i] = fmt.Sprint(rv.Index(i).Interface())
+		}
+		return strings.Join(values, ", ")
+	}
+	return fmt.Sprint(v)
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
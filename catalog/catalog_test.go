@@ -0,0 +1,143 @@
+package catalog_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/imulab/check"
+	"github.com/imulab/check/bigz"
+	"github.com/imulab/check/catalog"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/rangez"
+	"github.com/imulab/check/slicez"
+	"github.com/imulab/check/stringz"
+	"github.com/imulab/check/timez"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		params   check.Params
+		expect   string
+	}{
+		{name: "no reference", template: "must not be empty", expect: "must not be empty"},
+		{name: "references", template: "between {start} and {end}", params: check.Params{"start": 1, "end": 10}, expect: "between 1 and 10"},
+		{name: "slice", template: "one of {values}", params: check.Params{"values": []string{"a", "b"}}, expect: "one of a, b"},
+		{name: "absent", template: "must be {expected}", expect: "must be {expected}"},
+		{name: "unclosed", template: "must be {expected", params: check.Params{"expected": 1}, expect: "must be {expected"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, catalog.Format(c.template, c.params))
+		})
+	}
+}
+
+func TestRenderer_Message(t *testing.T) {
+	french := catalog.New()
+	french.Add("fr", map[string]string{
+		int64z.CodeInRange: "doit être au moins {start} et inférieur à {end}",
+	})
+	overrides := catalog.New()
+	overrides.Set("en", stringz.CodeIsNotEmpty, "is required")
+
+	renderer := catalog.NewRenderer(overrides, french)
+	inRangeErr := check.That(int64(12), int64z.InRange(1, 10))()
+
+	cases := []struct {
+		name    string
+		err     error
+		locales []string
+		expect  string
+	}{
+		{name: "default english", err: inRangeErr, expect: "must be at least 1 and less than 10"},
+		{name: "translated", err: inRangeErr, locales: []string{"fr"}, expect: "doit être au moins 1 et inférieur à 10"},
+		{name: "regional fallback", err: inRangeErr, locales: []string{"fr_CA"}, expect: "doit être au moins 1 et inférieur à 10"},
+		{name: "locale fallback", err: inRangeErr, locales: []string{"de", "fr"}, expect: "doit être au moins 1 et inférieur à 10"},
+		{name: "untranslated fallback", err: check.That("", stringz.IsNotEmpty)(), locales: []string{"fr"}, expect: "is required"},
+		{name: "value", err: check.That("foo", stringz.Is("bar"))(), expect: "must be bar"},
+		{
+			name: "path and aggregate",
+			err: check.AllErr(
+				check.That([]string{}, slicez.OfString.IsNotEmpty).Path("tags"),
+				check.That(int64(12), int64z.InRange(1, 10)).Path("age"),
+			),
+			expect: "tags: must not be empty; age: must be at least 1 and less than 10",
+		},
+		{
+			name:   "wrapped path",
+			err:    fmt.Errorf("decode: %w", check.That([]string{}, slicez.OfString.IsNotEmpty).Path("tags")()),
+			expect: "tags: must not be empty",
+		},
+		{name: "type error", err: check.That(1, stringz.IsEmpty)(), expect: "expected type string, got int"},
+		{
+			name:   "or error",
+			err:    check.That("foo", check.Or(stringz.IsEmpty, stringz.HasLength(5)))(),
+			expect: "must meet one of the conditions: must be empty; must have length 5",
+		},
+		{name: "plain error", err: errors.New("custom"), expect: "custom"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, renderer.Message(c.err, c.locales...))
+		})
+	}
+}
+
+func TestRenderer_MessageCtx(t *testing.T) {
+	french := catalog.New()
+	french.Set("fr", stringz.CodeIsNotEmpty, "ne doit pas être vide")
+	renderer := catalog.NewRenderer(french)
+
+	ctx := catalog.WithLocale(context.Background(), "fr-FR", "en")
+	assert.Equal(t, "ne doit pas être vide", renderer.MessageCtx(ctx, check.That("", stringz.IsNotEmpty)()))
+	assert.Equal(t, "must not be empty", renderer.MessageCtx(context.Background(), check.That("", stringz.IsNotEmpty)()))
+}
+
+func TestRenderer_Message_Duplicates(t *testing.T) {
+	err := check.That([]string{"a", "b", "a", "b"}, slicez.OfString.Unique)()
+	assert.Equal(t, "must not contain duplicates: a at [0 2], b at [1 3]", catalog.Default.Message(err))
+}
+
+func TestRenderer_Message_SetRelation(t *testing.T) {
+	err := check.That([]string{"read", "write", "admin"}, slicez.OfString.SubsetOf("read"))()
+	assert.Equal(t, "must only contain read, but also contains write, admin", catalog.Default.Message(err))
+}
+
+func TestRenderer_Message_Range(t *testing.T) {
+	err := check.That(int64(0), int64z.Within(rangez.Closed[int64](1, 100)))()
+	assert.Equal(t, "must be within [1, 100]", catalog.Default.Message(err))
+
+	err = check.That([]string{}, slicez.OfString.HasLengthWithin(rangez.AtLeast(1)))()
+	assert.Equal(t, "must have a number of elements within [1, +∞)", catalog.Default.Message(err))
+}
+
+func TestRenderer_Message_Time(t *testing.T) {
+	err := check.That(2*time.Hour, timez.DurationAtMost(time.Hour))()
+	assert.Equal(t, "must be at most 1h0m0s", catalog.Default.Message(err))
+
+	err = check.That(time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC), timez.Weekday(time.Monday, time.Friday))()
+	assert.Equal(t, "must be on Monday, Friday", catalog.Default.Message(err))
+}
+
+func TestRenderer_Message_BigRat(t *testing.T) {
+	min, _ := new(big.Rat).SetString("0.05")
+	err := check.That("0.01", bigz.Parse(bigz.Of[*big.Rat]().GreaterThanOrEqualTo(min)))()
+	assert.Equal(t, "must be greater than or equal to 0.05", catalog.Default.Message(err))
+}
+
+func TestRegister(t *testing.T) {
+	renderer := catalog.NewRenderer()
+	catalog.Register("en", map[string]string{"custom.even": "must be even, got {value}"})
+
+	err := check.NewError(errors.New("odd"), "custom.even", 3, nil)
+	assert.Equal(t, "must be even, got 3", renderer.Message(err))
+	assert.Equal(t, "must be even, got 3", catalog.Default.Message(err))
+}
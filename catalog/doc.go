@@ -0,0 +1,24 @@
+// Package catalog renders check errors into localized messages, by the code and parameters of the error.
+//
+// Messages are templates keyed by locale and code, where parameters are referenced by name in braces, and the
+// offending value is referenced as {value}:
+//
+//	"int64.in_range": "must be at least {start} and less than {end}"
+//
+// A Renderer consults its Translator in order for each requested locale, falling back from a regional locale to
+// its language (i.e. "fr-CA" to "fr"), and finally to the Fallback locale. The built-in messages are always
+// consulted last, so teams override individual messages by placing their own Catalog first.
+//
+// This package does not depend on the validator packages. Each of them ships its English messages as Messages, and
+// adds them to the built-in messages with Register when it is initialized, so messages are available for every
+// validator package linked into the program.
+//
+//	overrides := catalog.New()
+//	overrides.Set("en", int64z.CodeInRange, "must be between {start} and {end}, exclusive")
+//	overrides.Add("fr", frenchMessages)
+//
+//	renderer := catalog.NewRenderer(overrides)
+//	renderer.Message(err, "fr-CA", "en")
+//
+// Errors other than *check.Error, *check.TypeError and *check.OrError are rendered with their Error method.
+package catalog
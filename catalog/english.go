@@ -0,0 +1,12 @@
+package catalog
+
+import "github.com/imulab/check"
+
+// English contains the English message templates of the codes in the check package. Message templates of the
+// validator packages, i.e. stringz, are kept in those packages and registered with Register.
+var English = map[string]string{
+	check.CodeUnexpectedType: "expected type {expected}, got {actual}",
+	check.CodeOr:             "must meet one of the conditions: {errors}",
+	check.CodeNot:            "must not meet the condition",
+	check.CodeXor:            "must meet exactly one of the conditions, but met {passed}",
+}
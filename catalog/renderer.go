@@ -0,0 +1,151 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"github.com/imulab/check"
+	"strings"
+)
+
+// builtin holds the built-in messages: English of the check package, and those registered by validator packages.
+var builtin = func() *Catalog {
+	c := New()
+	c.Add("en", English)
+	return c
+}()
+
+// Register adds the message templates, keyed by code, for the locale to the built-in messages consulted by every
+// Renderer created with NewRenderer, including those created before. Validator packages, i.e. stringz, call it from
+// init to ship their default English messages, and third party rule packages can do the same.
+func Register(locale string, templates map[string]string) {
+	builtin.Add(locale, templates)
+}
+
+// Default is the Renderer with only the built-in messages.
+var Default = NewRenderer()
+
+// Renderer renders check errors into messages through its Translators.
+type Renderer struct {
+	// Translators are consulted in order, for each locale in turn.
+	Translators []Translator
+	// Fallback is the locale consulted after all requested locales.
+	Fallback string
+}
+
+// NewRenderer creates a Renderer which consults the given translators in order, followed by the built-in messages
+// (see Register). The Fallback locale is "en".
+func NewRenderer(translators ...Translator) *Renderer {
+	return &Renderer{
+		Translators: append(append([]Translator{}, translators...), builtin),
+		Fallback:    "en",
+	}
+}
+
+// Message renders the message of the error in the first of the requested locales that has it available. A
+// regional locale falls back to its language, i.e. "fr-CA" to "fr", before moving on, and the Fallback locale is
+// consulted last. Path annotations are kept as prefix, and members of check.Errors are joined with "; ".
+func (r *Renderer) Message(err error, locales ...string) string {
+	if err == nil {
+		return ""
+	}
+
+	switch e := annotation(err).(type) {
+	case check.Errors:
+		messages := make([]string, 0, len(e))
+		for _, it := range e {
+			messages = append(messages, r.Message(it, locales...))
+		}
+		return strings.Join(messages, "; ")
+	case *check.PathError:
+		return e.Path + ": " + r.Message(e.Err, locales...)
+	}
+
+	code, params, ok := r.describe(err, locales)
+	if !ok {
+		return err.Error()
+	}
+	for _, locale := range r.candidates(locales) {
+		for _, t := range r.Translators {
+			if message, ok := t.Translate(locale, code, params); ok {
+				return message
+			}
+		}
+	}
+	return err.Error()
+}
+
+// MessageCtx is like Message, but uses the locales in the context (see WithLocale).
+func (r *Renderer) MessageCtx(ctx context.Context, err error) string {
+	return r.Message(err, LocalesFrom(ctx)...)
+}
+
+// describe returns the code and the parameters (including the offending value) of the error.
+func (r *Renderer) describe(err error, locales []string) (string, check.Params, bool) {
+	var (
+		orErr   *check.OrError
+		typeErr *check.TypeError
+		e       *check.Error
+	)
+	switch {
+	case errors.As(err, &orErr):
+		messages := make([]string, 0, len(orErr.Errors))
+		for _, it := range orErr.Errors {
+			messages = append(messages, r.Message(it, locales...))
+		}
+		return check.CodeOr, check.Params{"errors": strings.Join(messages, "; ")}, true
+	case errors.As(err, &typeErr):
+		return check.CodeUnexpectedType, check.Params{"expected": typeErr.Expected, "actual": typeErr.Actual}, true
+	case errors.As(err, &e):
+		params := check.Params{"value": e.Value}
+		for k, v := range e.Params {
+			params[k] = v
+		}
+		return e.Code, params, true
+	default:
+		return "", nil, false
+	}
+}
+
+// annotation returns the outermost check.Errors or *check.PathError in the chain of err, which may be wrapped i.e.
+// by fmt.Errorf. It returns nil if a described error comes first, as an OrError also unwraps to check.Errors.
+func annotation(err error) error {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		switch e.(type) {
+		case check.Errors, *check.PathError:
+			return e
+		case *check.OrError, *check.TypeError, *check.Error:
+			return nil
+		}
+	}
+	return nil
+}
+
+// candidates expands the requested locales with their languages, followed by the Fallback locale.
+func (r *Renderer) candidates(locales []string) []string {
+	var candidates []string
+	for _, it := range append(append([]string{}, locales...), r.Fallback) {
+		it = normalize(it)
+		for len(it) > 0 {
+			candidates = append(candidates, it)
+			i := strings.LastIndexByte(it, '-')
+			if i < 0 {
+				break
+			}
+			it = it[:i]
+		}
+	}
+	return candidates
+}
+
+type localesKey struct{}
+
+// WithLocale returns a copy of the context carrying the locales of the request, in order of preference.
+func WithLocale(ctx context.Context, locales ...string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// LocalesFrom returns the locales carried by the context, or nil.
+func LocalesFrom(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
+}
@@ -7,6 +7,9 @@ import (
 var (
 	// Skip is the special error to return in order to skip the rest of validation.
 	Skip = errors.New("skip")
+	// ErrUnexpectedType is returned (wrapped in TypeError) by the built-in Step when the target is not of the
+	// type the Step expects. Use errors.Is to test against it, and errors.As to obtain the TypeError.
+	ErrUnexpectedType = errors.New("unexpected target type")
 )
 
 // Step is a single validation step.
@@ -93,6 +96,16 @@ func (f ErrFunc) Err(err error) ErrFunc {
 	}
 }
 
+// Path returns a wrapper ErrFunc to annotate any returned error with the path of the validated target. See WithPath
+// for how paths compose.
+//
+//	check.That(user.Addresses[2].Zip, stringz.IsNotEmpty).Path("user.addresses[2].zip")
+func (f ErrFunc) Path(path string) ErrFunc {
+	return func() error {
+		return WithPath(path, f())
+	}
+}
+
 // That is the entrypoint for performing the validation Step. All supplied validation Step are
 // performed sequentially unless an error is returned, or a Step returned Skip.
 func That(target interface{}, steps ...Step) ErrFunc {
@@ -120,3 +133,26 @@ func AnyErr(ef ...ErrFunc) error {
 	}
 	return nil
 }
+
+// AllErr is like AnyErr, but invokes every ErrFunc regardless of failures. All returned errors are collected
+// into Errors, in the order of the supplied ErrFunc. If no ErrFunc returned an error, nil is returned.
+//
+//	err := check.AllErr(
+//		check.That(form.Name, stringz.IsNotEmpty),
+//		check.That(form.Email, stringz.Contains("@")),
+//	)
+//	if errs, ok := err.(check.Errors); ok {
+//		for _, it := range errs { ... }
+//	}
+func AllErr(ef ...ErrFunc) error {
+	var errs Errors
+	for _, it := range ef {
+		if err := it(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
@@ -51,3 +51,82 @@ func TestAnyErr(t *testing.T) {
 	)
 	assert.Error(t, err)
 }
+
+func TestAllErr(t *testing.T) {
+	var (
+		errOne = errors.New("one")
+		errTwo = errors.New("two")
+	)
+
+	err := check.AllErr(
+		check.That("foo", wrongStep).Err(errOne),
+		check.That("foo", correctStep),
+		check.That("foo", wrongStep).Err(errTwo),
+	)
+	assert.Equal(t, check.Errors{errOne, errTwo}, err)
+	assert.True(t, errors.Is(err, errOne))
+	assert.True(t, errors.Is(err, errTwo))
+	assert.False(t, errors.Is(err, check.Skip))
+	assert.Equal(t, "one; two", err.Error())
+
+	assert.NoError(t, check.AllErr(check.That("foo", correctStep)))
+}
+
+func TestErrFunc_Path(t *testing.T) {
+	var customErr = errors.New("customErr")
+
+	err := check.That("foo", wrongStep.Err(customErr)).Path("user.name")()
+	assert.Equal(t, &check.PathError{Path: "user.name", Err: customErr}, err)
+	assert.True(t, errors.Is(err, customErr))
+	assert.Equal(t, "user.name: customErr", err.Error())
+
+	assert.NoError(t, check.That("foo", correctStep).Path("user.name")())
+}
+
+func TestWithPath(t *testing.T) {
+	var customErr = errors.New("customErr")
+
+	cases := []struct {
+		name   string
+		err    error
+		expect error
+	}{
+		{name: "nil", err: nil, expect: nil},
+		{name: "skip", err: check.Skip, expect: check.Skip},
+		{name: "plain", err: customErr, expect: &check.PathError{Path: "user", Err: customErr}},
+		{
+			name:   "nested field",
+			err:    check.WithPath("addresses", check.WithIndex(2, check.WithPath("zip", customErr))),
+			expect: &check.PathError{Path: "user.addresses[2].zip", Err: customErr},
+		},
+		{
+			name: "aggregate",
+			err:  check.Errors{check.WithPath("name", customErr), customErr},
+			expect: check.Errors{
+				&check.PathError{Path: "user.name", Err: customErr},
+				&check.PathError{Path: "user", Err: customErr},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, check.WithPath("user", c.err))
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	var sentinel = errors.New("int64 value is not in range")
+
+	err := check.WithPath("age", check.NewError(sentinel, "int64.in_range", int64(12), check.Params{"start": 1, "end": 10}))
+	assert.True(t, errors.Is(err, sentinel))
+	assert.Equal(t, "age: int64 value is not in range", err.Error())
+
+	var e *check.Error
+	if assert.True(t, errors.As(err, &e)) {
+		assert.Equal(t, "int64.in_range", e.Code)
+		assert.Equal(t, int64(12), e.Value)
+		assert.Equal(t, check.Params{"start": 1, "end": 10}, e.Params)
+	}
+}
@@ -0,0 +1,254 @@
+package checktag
+
+import (
+	"fmt"
+	"github.com/imulab/check"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Validate validates v with the rules in the Default Registry. See Registry.Validate.
+func Validate(v interface{}) error {
+	return Default.Validate(v)
+}
+
+// Validate validates the struct (or pointer to struct, or slice of them) v according to the check tags of its
+// fields, using the rules in this Registry. Validation failures are returned as check.Errors with path annotated.
+// If a check tag cannot be understood, a TagError is returned instead.
+func (r *Registry) Validate(v interface{}) error {
+	errs, err := r.validate(reflect.ValueOf(v), map[visit]bool{})
+	if err != nil {
+		return err
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// field is the compiled validation plan of a struct field.
+type field struct {
+	index     int
+	name      string
+	anonymous bool
+	kind      Kind
+	steps     []check.Step
+}
+
+// visit identifies a pointer being followed, by its address and type, as a struct and its first field share the
+// same address.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// validate walks v, following pointers not yet on the way from the root in visiting, so cyclic values terminate.
+func (r *Registry) validate(v reflect.Value, visiting map[visit]bool) (check.Errors, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Kind() == reflect.Ptr {
+			key := visit{ptr: v.Pointer(), typ: v.Type()}
+			if visiting[key] {
+				return nil, nil
+			}
+			visiting[key] = true
+			defer delete(visiting, key)
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	var errs check.Errors
+
+	switch v.Kind() {
+	case reflect.Struct:
+		fields, err := r.planOf(v.Type())
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			fv := v.Field(f.index)
+
+			if len(f.steps) > 0 {
+				if target, ok := canonical(fv, f.kind); ok {
+					if err := check.That(target, f.steps...)(); err != nil {
+						errs = append(errs, check.WithPath(f.name, err))
+					}
+				} else if overflows(fv) {
+					errs = append(errs, check.WithPath(f.name, check.NewError(ErrOverflow, CodeOverflow,
+						fv.Interface(), check.Params{"max": int64(math.MaxInt64)})))
+				}
+			}
+
+			nested, err := r.validate(fv, visiting)
+			if err != nil {
+				return nil, err
+			}
+			for _, it := range nested {
+				if f.anonymous {
+					errs = append(errs, it)
+				} else {
+					errs = append(errs, check.WithPath(f.name, it))
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if !walkable(v.Type().Elem()) {
+			return nil, nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			nested, err := r.validate(v.Index(i), visiting)
+			if err != nil {
+				return nil, err
+			}
+			for _, it := range nested {
+				errs = append(errs, check.WithIndex(i, it))
+			}
+		}
+	}
+
+	return errs, nil
+}
+
+func (r *Registry) planOf(t reflect.Type) ([]field, error) {
+	if p, ok := r.plans.Load(t); ok {
+		return p.([]field), nil
+	}
+
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("check")
+		if tag == "-" || (len(sf.PkgPath) > 0 && !sf.Anonymous) {
+			continue
+		}
+
+		f := field{index: i, name: nameOf(sf), anonymous: sf.Anonymous && len(tag) == 0}
+		if len(tag) > 0 {
+			k, ok := kindOf(sf.Type)
+			if !ok {
+				return nil, &TagError{Struct: t.String(), Field: sf.Name, Rule: tag, Err: ErrUnsupportedKind}
+			}
+			f.kind = k
+			for _, it := range strings.Split(tag, ",") {
+				name, param := it, ""
+				if j := strings.Index(it, "="); j >= 0 {
+					name, param = it[:j], it[j+1:]
+				}
+				rule, ok := r.lookup(k, name)
+				if !ok {
+					return nil, &TagError{Struct: t.String(), Field: sf.Name, Rule: it, Err: ErrUnknownRule}
+				}
+				step, err := rule(param)
+				if err != nil {
+					return nil, &TagError{Struct: t.String(), Field: sf.Name, Rule: it, Err: err}
+				}
+				f.steps = append(f.steps, step)
+			}
+		}
+
+		if len(f.steps) > 0 || walkable(sf.Type) {
+			fields = append(fields, f)
+		}
+	}
+
+	r.plans.Store(t, fields)
+	return fields, nil
+}
+
+// nameOf returns the name of the field in the "json" tag, or the Go field name.
+func nameOf(sf reflect.StructField) string {
+	if name := strings.Split(sf.Tag.Get("json"), ",")[0]; len(name) > 0 && name != "-" {
+		return name
+	}
+	return sf.Name
+}
+
+// kindOf returns the canonical kind of rules applicable to the type, dereferencing pointers.
+func kindOf(t reflect.Type) (Kind, bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return String, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Int64, true
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.String {
+			return Strings, true
+		}
+	}
+	return 0, false
+}
+
+// canonical converts the field value to the canonical type of the kind. It returns false for nil pointers, and for
+// unsigned values which do not fit in int64 (see overflows).
+func canonical(v reflect.Value, k Kind) (interface{}, bool) {
+	v, ok := indirect(v)
+	if !ok {
+		return nil, false
+	}
+	switch k {
+	case String:
+		return v.String(), true
+	case Int64:
+		if v.CanInt() {
+			return v.Int(), true
+		}
+		if u := v.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+		return nil, false
+	case Strings:
+		strs := make([]string, v.Len())
+		for i := range strs {
+			strs[i] = v.Index(i).String()
+		}
+		return strs, true
+	default:
+		panic(fmt.Sprintf("checktag: unexpected kind %d", k))
+	}
+}
+
+// overflows reports whether the field value is an unsigned integer beyond math.MaxInt64, which int64 rules cannot
+// compare against without wrapping around.
+func overflows(v reflect.Value) bool {
+	v, ok := indirect(v)
+	return ok && v.CanUint() && v.Uint() > math.MaxInt64
+}
+
+// walkable reports whether values of the type may contain nested structs to validate. Types already seen are not
+// followed again, so recursive types such as "type tree []tree" terminate.
+func walkable(t reflect.Type) bool {
+	seen := map[reflect.Type]bool{}
+	for !seen[t] {
+		seen[t] = true
+		switch t.Kind() {
+		case reflect.Struct:
+			return true
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// indirect dereferences pointers and interfaces, and returns false if a nil is encountered.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, v.IsValid()
+}
@@ -0,0 +1,210 @@
+package checktag_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/imulab/check"
+	"github.com/imulab/check/checktag"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/slicez"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type role string
+
+type address struct {
+	Zip string `json:"zip" check:"nonempty,len=5"`
+}
+
+type user struct {
+	Name      string     `json:"name" check:"nonempty,len=1..10"`
+	Role      role       `json:"role" check:"in=admin|member"`
+	Age       int32      `json:"age" check:"range=18..150"`
+	Tags      []string   `json:"tags" check:"len=0..2"`
+	Primary   *address   `json:"primary"`
+	Addresses []*address `json:"addresses"`
+	Note      string     `check:"-"`
+	internal  string
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *user {
+		return &user{
+			Name:      "foo",
+			Role:      "admin",
+			Age:       18,
+			Tags:      []string{"a", "b"},
+			Addresses: []*address{{Zip: "12345"}, nil},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		modify func(u *user)
+		expect check.Errors
+	}{
+		{name: "valid", modify: func(u *user) {}},
+		{
+			name:   "string rules",
+			modify: func(u *user) { u.Name = "" },
+			expect: check.Errors{&check.PathError{Path: "name", Err: stringz.ErrIsNotEmpty}},
+		},
+		{
+			name:   "named string type",
+			modify: func(u *user) { u.Role = "guest" },
+			expect: check.Errors{&check.PathError{Path: "role", Err: stringz.ErrIn}},
+		},
+		{
+			name:   "inclusive range",
+			modify: func(u *user) { u.Age = 151 },
+			expect: check.Errors{&check.PathError{Path: "age", Err: int64z.ErrInRange}},
+		},
+		{
+			name:   "slice rules",
+			modify: func(u *user) { u.Tags = []string{"a", "b", "c"} },
+			expect: check.Errors{&check.PathError{Path: "tags", Err: slicez.ErrHasLengthInRange}},
+		},
+		{
+			name:   "nested struct",
+			modify: func(u *user) { u.Primary = &address{Zip: "1"} },
+			expect: check.Errors{&check.PathError{Path: "primary.zip", Err: stringz.ErrHasLength}},
+		},
+		{
+			name: "all failures with element path",
+			modify: func(u *user) {
+				u.Name = "foobarfoobar"
+				u.Addresses = append(u.Addresses, &address{})
+			},
+			expect: check.Errors{
+				&check.PathError{Path: "name", Err: stringz.ErrHasLengthInRange},
+				&check.PathError{Path: "addresses[2].zip", Err: stringz.ErrIsNotEmpty},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			u := valid()
+			c.modify(u)
+			err := checktag.Validate(u)
+			if c.expect == nil {
+				assert.NoError(t, err)
+			} else {
+				assertErrors(t, c.expect, err)
+			}
+		})
+	}
+}
+
+func TestValidate_Slice(t *testing.T) {
+	err := checktag.Validate([]address{{Zip: "12345"}, {Zip: ""}})
+	assertErrors(t, check.Errors{&check.PathError{Path: "[1].zip", Err: stringz.ErrIsNotEmpty}}, err)
+}
+
+type node struct {
+	Name string `json:"name" check:"nonempty"`
+	Next *node  `json:"next"`
+}
+
+type tree []tree
+
+func TestValidate_Cyclic(t *testing.T) {
+	a := &node{Name: "a"}
+	b := &node{Next: a}
+	a.Next = b
+
+	err := checktag.Validate(a)
+	assertErrors(t, check.Errors{&check.PathError{Path: "next.name", Err: stringz.ErrIsNotEmpty}}, err)
+
+	self := &node{}
+	self.Next = self
+	assertErrors(t, check.Errors{&check.PathError{Path: "name", Err: stringz.ErrIsNotEmpty}}, checktag.Validate(self))
+
+	assert.NoError(t, checktag.Validate(struct{ Children tree }{Children: tree{{}, {}}}))
+}
+
+func TestValidate_Unsigned(t *testing.T) {
+	type quota struct {
+		Count uint   `json:"count" check:"lte=100"`
+		Bytes uint64 `json:"bytes" check:"positive"`
+	}
+
+	assert.NoError(t, checktag.Validate(quota{Count: 100, Bytes: 1}))
+	assertErrors(t, check.Errors{
+		&check.PathError{Path: "count", Err: int64z.ErrLessThanOrEqualTo},
+		&check.PathError{Path: "bytes", Err: checktag.ErrOverflow},
+	}, checktag.Validate(quota{Count: 101, Bytes: math.MaxUint64}))
+}
+
+func TestValidate_TagError(t *testing.T) {
+	cases := []struct {
+		name   string
+		target interface{}
+		err    error
+	}{
+		{
+			name: "unknown rule",
+			target: struct {
+				Name string `check:"positive"`
+			}{},
+			err: checktag.ErrUnknownRule,
+		},
+		{
+			name: "invalid param",
+			target: struct {
+				Age int `check:"gt=ten"`
+			}{},
+			err: checktag.ErrInvalidParam,
+		},
+		{
+			name: "unsupported kind",
+			target: struct {
+				Ratio float64 `check:"positive"`
+			}{},
+			err: checktag.ErrUnsupportedKind,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checktag.Validate(c.target)
+			var tagErr *checktag.TagError
+			assert.True(t, errors.As(err, &tagErr))
+			assert.True(t, errors.Is(err, c.err))
+		})
+	}
+}
+
+// assertErrors asserts err is check.Errors, whose members have the same paths as the expected check.PathError,
+// and match the expected errors with errors.Is.
+func assertErrors(t *testing.T, expect check.Errors, err error) {
+	errs, ok := err.(check.Errors)
+	if !assert.True(t, ok) || !assert.Len(t, errs, len(expect)) {
+		return
+	}
+	for i, it := range expect {
+		var actual *check.PathError
+		if assert.True(t, errors.As(errs[i], &actual)) {
+			assert.Equal(t, it.(*check.PathError).Path, actual.Path)
+			assert.True(t, errors.Is(actual.Err, it.(*check.PathError).Err))
+		}
+	}
+}
+
+func TestValidate_RangeParams(t *testing.T) {
+	u := &user{Name: "foo", Role: "admin", Age: 151, Tags: []string{"a", "b", "c"}}
+
+	errs, ok := checktag.Validate(u).(check.Errors)
+	if !assert.True(t, ok) || !assert.Len(t, errs, 2) {
+		return
+	}
+	for i, expect := range []string{"[18, 150]", "[0, 2]"} {
+		var checkErr *check.Error
+		if assert.True(t, errors.As(errs[i], &checkErr)) {
+			assert.Equal(t, expect, fmt.Sprint(checkErr.Params["range"]))
+		}
+	}
+}
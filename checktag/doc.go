@@ -0,0 +1,42 @@
+// Package checktag validates structs according to the "check" struct tag, by mapping tag rules to the check.Step
+// implementations in stringz, int64z and slicez. It is opt-in: the check package itself stays reflection-free.
+//
+//	type Address struct {
+//		Zip string `json:"zip" check:"nonempty,len=5"`
+//	}
+//
+//	type User struct {
+//		Name      string    `json:"name" check:"nonempty,len=1..10"`
+//		Role      string    `json:"role" check:"in=admin|member"`
+//		Age       int       `json:"age" check:"gte=18"`
+//		Tags      []string  `json:"tags" check:"len=0..3"`
+//		Addresses []Address `json:"addresses"`
+//	}
+//
+//	err := checktag.Validate(user)
+//
+// Rules are separated by comma, and a rule may take a parameter after "=". Multiple values in a parameter are
+// separated by "|", and ranges are written as "a..b", which includes both ends. Supported rules depend on the
+// kind of the field:
+//
+//	string kinds:            nonempty, empty, is=, isnot=, in=, len=n, len=a..b, prefix=, suffix=, contains=, match=
+//	integer kinds:           eq=, ne=, gt=, gte=, lt=, lte=, range=a..b, zero, positive, negative, nonpositive, nonnegative
+//	slices of string kinds:  nonempty, empty, len=n, len=a..b, contains=, notcontain=
+//
+// Rules of a field are executed sequentially like check.That, and the first error is reported. Nested structs,
+// pointers to structs, and slices of them are walked automatically, and every failing field is reported. Errors
+// are collected into check.Errors, where each error is annotated (see check.WithPath) with the path to the field,
+// i.e. "addresses[2].zip". The path uses the name in the "json" tag when present, or the Go field name otherwise.
+//
+// Integer rules compare as int64. An unsigned field holding a value beyond math.MaxInt64 is reported as ErrOverflow
+// rather than wrapping around to a negative number.
+//
+// Custom rules are registered by name into a Registry, as ordinary check.Step factories. Validate uses the
+// Default Registry, and services may create their own scoped Registry with NewRegistry.
+//
+//	registry := checktag.NewRegistry()
+//	registry.MustRegister(checktag.String, "sku", checktag.NoParam(skuStep))
+//	registry.MustRegister(checktag.Int64, "tenant_id", checktag.Int64Param(tenantStep))
+//
+// Fields tagged with `check:"-"`, unexported fields, and nil pointers are not validated.
+package checktag
@@ -0,0 +1,39 @@
+package checktag
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownRule     = errors.New("unknown rule")
+	ErrInvalidParam    = errors.New("invalid rule parameter")
+	ErrUnsupportedKind = errors.New("rules are not supported on field kind")
+	ErrDuplicateRule   = errors.New("rule is already registered")
+	ErrInvalidRuleName = errors.New("invalid rule name")
+	ErrOverflow        = errors.New("value overflows int64")
+)
+
+// CodeOverflow is the code of the check.Error matching ErrOverflow, which is reported instead of running the
+// integer rules on an unsigned field whose value exceeds math.MaxInt64.
+const CodeOverflow = "checktag.overflow"
+
+// TagError is returned when the check tag of a struct field cannot be understood. It indicates a programming
+// error rather than a validation failure, and unwraps to one of ErrUnknownRule, ErrInvalidParam or
+// ErrUnsupportedKind.
+type TagError struct {
+	Struct string
+	Field  string
+	Rule   string
+	Err    error
+}
+
+// Error reports the struct field and the rule that cannot be understood.
+func (e *TagError) Error() string {
+	return fmt.Sprintf("checktag: %s.%s: %s: %s", e.Struct, e.Field, e.Rule, e.Err)
+}
+
+// Unwrap returns the cause of the error.
+func (e *TagError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,12 @@
+package checktag
+
+import "github.com/imulab/check/catalog"
+
+// Messages contains the English message templates of the checktag codes, registered into catalog on init.
+var Messages = map[string]string{
+	CodeOverflow: "must be at most {max}",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
@@ -0,0 +1,104 @@
+package checktag
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Default is the Registry used by Validate. It contains the built-in rules.
+var Default = NewRegistry()
+
+// Registry holds the rules available to the check tag, by Kind and name. Each Registry is independent, so that
+// services can scope their domain rules in their own Registry. A Registry is safe for concurrent use.
+//
+//	registry := checktag.NewRegistry()
+//	registry.MustRegister(checktag.String, "sku", checktag.NoParam(skuStep))
+//
+//	type Item struct {
+//		SKU string `check:"nonempty,sku"`
+//	}
+//	err := registry.Validate(item)
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[Kind]map[string]Rule
+	plans sync.Map // reflect.Type -> []field
+}
+
+// RuleInfo describes a registered rule.
+type RuleInfo struct {
+	Kind Kind
+	Name string
+}
+
+// NewRegistry creates a new Registry containing the built-in rules.
+func NewRegistry() *Registry {
+	r := &Registry{rules: map[Kind]map[string]Rule{}}
+	for k, rules := range builtin {
+		for name, rule := range rules {
+			r.MustRegister(k, name, rule)
+		}
+	}
+	return r
+}
+
+// Register adds the rule under the name for the Kind. If the name is already registered for the Kind, an error
+// wrapping ErrDuplicateRule is returned. Rule names must not contain ",", "=" or whitespace.
+func (r *Registry) Register(kind Kind, name string, rule Rule) error {
+	if len(name) == 0 || strings.ContainsAny(name, ",= \t") {
+		return fmt.Errorf("%w: %q", ErrInvalidRuleName, name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.rules[kind][name]; ok {
+		return fmt.Errorf("%w: %s rule %q", ErrDuplicateRule, kind, name)
+	}
+	if r.rules[kind] == nil {
+		r.rules[kind] = map[string]Rule{}
+	}
+	r.rules[kind][name] = rule
+
+	// compiled plans may have failed on or shadowed the new rule
+	r.plans.Range(func(key, _ interface{}) bool {
+		r.plans.Delete(key)
+		return true
+	})
+	return nil
+}
+
+// MustRegister is like Register, but panics on error. It is intended to be used during initialization.
+func (r *Registry) MustRegister(kind Kind, name string, rule Rule) {
+	if err := r.Register(kind, name, rule); err != nil {
+		panic(err)
+	}
+}
+
+// Rules lists all registered rules, ordered by Kind and then by name. It is useful for documentation.
+func (r *Registry) Rules() []RuleInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var infos []RuleInfo
+	for k, rules := range r.rules {
+		for name := range rules {
+			infos = append(infos, RuleInfo{Kind: k, Name: name})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Kind != infos[j].Kind {
+			return infos[i].Kind < infos[j].Kind
+		}
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+func (r *Registry) lookup(kind Kind, name string) (Rule, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	rule, ok := r.rules[kind][name]
+	return rule, ok
+}
@@ -0,0 +1,72 @@
+package checktag_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/checktag"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+var errSKU = errors.New("invalid sku")
+
+type item struct {
+	SKU      string `json:"sku" check:"nonempty,sku"`
+	TenantID int64  `json:"tenant_id" check:"tenant_id=100"`
+}
+
+func newRegistry(t *testing.T) *checktag.Registry {
+	registry := checktag.NewRegistry()
+	assert.NoError(t, registry.Register(checktag.String, "sku",
+		checktag.NoParam(stringz.Matches(regexp.MustCompile(`^[A-Z]{3}-\d{4}$`)).Err(errSKU))))
+	assert.NoError(t, registry.Register(checktag.Int64, "tenant_id",
+		checktag.Int64Param(func(max int64) check.Step {
+			return func(target interface{}) error {
+				if target.(int64) <= max {
+					return nil
+				}
+				return errors.New("unknown tenant")
+			}
+		})))
+	return registry
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	registry := newRegistry(t)
+
+	assert.NoError(t, registry.Validate(item{SKU: "ABC-1234", TenantID: 7}))
+	assertErrors(t, check.Errors{&check.PathError{Path: "sku", Err: errSKU}},
+		registry.Validate(item{SKU: "abc", TenantID: 7}))
+	assert.Error(t, registry.Validate(item{SKU: "ABC-1234", TenantID: 101}))
+
+	// rules are scoped to the registry
+	assert.True(t, errors.Is(checktag.Validate(item{}), checktag.ErrUnknownRule))
+}
+
+func TestRegistry_Register(t *testing.T) {
+	registry := newRegistry(t)
+
+	err := registry.Register(checktag.String, "sku", checktag.NoParam(stringz.IsNotEmpty))
+	assert.True(t, errors.Is(err, checktag.ErrDuplicateRule))
+
+	err = registry.Register(checktag.String, "nonempty", checktag.NoParam(stringz.IsNotEmpty))
+	assert.True(t, errors.Is(err, checktag.ErrDuplicateRule))
+
+	err = registry.Register(checktag.String, "a=b", checktag.NoParam(stringz.IsNotEmpty))
+	assert.True(t, errors.Is(err, checktag.ErrInvalidRuleName))
+
+	// the same name under a different kind is allowed
+	assert.NoError(t, registry.Register(checktag.Strings, "sku", checktag.NoParam(check.Optional)))
+}
+
+func TestRegistry_Rules(t *testing.T) {
+	registry := newRegistry(t)
+	rules := registry.Rules()
+
+	assert.Contains(t, rules, checktag.RuleInfo{Kind: checktag.String, Name: "sku"})
+	assert.Contains(t, rules, checktag.RuleInfo{Kind: checktag.Int64, Name: "tenant_id"})
+	assert.Contains(t, rules, checktag.RuleInfo{Kind: checktag.Strings, Name: "nonempty"})
+	assert.NotContains(t, checktag.Default.Rules(), checktag.RuleInfo{Kind: checktag.String, Name: "sku"})
+}
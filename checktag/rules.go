@@ -0,0 +1,198 @@
+package checktag
+
+import (
+	"fmt"
+	"github.com/imulab/check"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/rangez"
+	"github.com/imulab/check/slicez"
+	"github.com/imulab/check/stringz"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kind is the canonical type a rule is applied to. Field values are converted to the canonical type before
+// validation, so that named types and integers of other sizes work with the check.Step created by the rule.
+type Kind int
+
+const (
+	// String rules receive a string target, and apply to fields of string kinds.
+	String Kind = iota + 1
+	// Int64 rules receive an int64 target, and apply to fields of integer kinds.
+	Int64
+	// Strings rules receive a []string target, and apply to slices of string kinds.
+	Strings
+)
+
+// String returns the name of the Kind.
+func (k Kind) String() string {
+	switch k {
+	case String:
+		return "string"
+	case Int64:
+		return "int64"
+	case Strings:
+		return "strings"
+	default:
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Rule creates a check.Step from the parameter of the rule in the tag, that is, the part after "=". Parameter that
+// cannot be understood should be reported as an error wrapping ErrInvalidParam. Use the parameter helpers, i.e.
+// Int64Param, to parse typed parameters.
+type Rule func(param string) (check.Step, error)
+
+var builtin = map[Kind]map[string]Rule{
+	String: {
+		"nonempty": NoParam(stringz.IsNotEmpty),
+		"empty":    NoParam(stringz.IsEmpty),
+		"is":       StringParam(stringz.Is),
+		"isnot":    StringParam(stringz.IsNot),
+		"in":       StringsParam(stringz.In),
+		"len":      lengthRule(stringz.HasLength, stringz.HasLengthWithin),
+		"prefix":   StringParam(stringz.HasPrefix),
+		"suffix":   StringParam(stringz.HasSuffix),
+		"contains": StringParam(stringz.Contains),
+		"match": func(param string) (check.Step, error) {
+			pattern, err := regexp.Compile(param)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidParam, err)
+			}
+			return stringz.Matches(pattern), nil
+		},
+	},
+	Int64: {
+		"eq":          Int64Param(int64z.Equals),
+		"ne":          Int64Param(int64z.NotEqual),
+		"gt":          Int64Param(int64z.GreaterThan),
+		"gte":         Int64Param(int64z.GreaterThanOrEqualTo),
+		"lt":          Int64Param(int64z.LessThan),
+		"lte":         Int64Param(int64z.LessThanOrEqualTo),
+		"zero":        NoParam(int64z.Zero),
+		"positive":    NoParam(int64z.Positive),
+		"negative":    NoParam(int64z.Negative),
+		"nonpositive": NoParam(int64z.NonPositive),
+		"nonnegative": NoParam(int64z.NonNegative),
+		"range": RangeParam(func(start int64, end int64) check.Step {
+			return int64z.Within(rangez.Closed(start, end))
+		}),
+	},
+	Strings: {
+		"nonempty":   NoParam(slicez.OfString.IsNotEmpty),
+		"empty":      NoParam(slicez.OfString.IsEmpty),
+		"len":        lengthRule(slicez.OfString.HasLength, slicez.OfString.HasLengthWithin),
+		"contains":   StringParam(slicez.OfString.Contains),
+		"notcontain": StringParam(slicez.OfString.NotContain),
+	},
+}
+
+// NoParam returns a Rule which does not accept parameter, and always creates the given check.Step.
+func NoParam(step check.Step) Rule {
+	return func(param string) (check.Step, error) {
+		if len(param) > 0 {
+			return nil, fmt.Errorf("%w: rule does not accept parameter", ErrInvalidParam)
+		}
+		return step, nil
+	}
+}
+
+// StringParam returns a Rule which passes the parameter as is.
+func StringParam(f func(string) check.Step) Rule {
+	return func(param string) (check.Step, error) {
+		return f(param), nil
+	}
+}
+
+// StringsParam returns a Rule which splits the parameter into multiple values separated by "|".
+func StringsParam(f func(...string) check.Step) Rule {
+	return func(param string) (check.Step, error) {
+		return f(strings.Split(param, "|")...), nil
+	}
+}
+
+// Int64Param returns a Rule which parses the parameter as a decimal int64.
+func Int64Param(f func(int64) check.Step) Rule {
+	return func(param string) (check.Step, error) {
+		i, err := parseInt64(param)
+		if err != nil {
+			return nil, err
+		}
+		return f(i), nil
+	}
+}
+
+// RangeParam returns a Rule which parses the parameter as an inclusive range "a..b" of int64.
+func RangeParam(f func(start int64, end int64) check.Step) Rule {
+	return func(param string) (check.Step, error) {
+		start, end, err := parseRange(param)
+		if err != nil {
+			return nil, err
+		}
+		return f(start, end), nil
+	}
+}
+
+// lengthRule returns a Rule which parses the parameter as either an exact length "n", or an inclusive range "a..b"
+// passed as is, so that errors report it as "[a, b]".
+func lengthRule(exact func(int) check.Step, within func(rangez.Range[int]) check.Step) Rule {
+	return func(param string) (check.Step, error) {
+		if !strings.Contains(param, "..") {
+			length, err := parseInt64(param)
+			if err != nil {
+				return nil, err
+			}
+			if err := fitsInt(param, length); err != nil {
+				return nil, err
+			}
+			return exact(int(length)), nil
+		}
+		start, end, err := parseRange(param)
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range []int64{start, end} {
+			if err := fitsInt(param, it); err != nil {
+				return nil, err
+			}
+		}
+		return within(rangez.Closed(int(start), int(end))), nil
+	}
+}
+
+// fitsInt returns ErrInvalidParam if the length does not fit in int, which is 32 bits wide on some platforms.
+func fitsInt(param string, length int64) error {
+	if int64(int(length)) != length {
+		return fmt.Errorf("%w: %q is out of range", ErrInvalidParam, param)
+	}
+	return nil
+}
+
+func parseInt64(param string) (int64, error) {
+	i, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not an integer", ErrInvalidParam, param)
+	}
+	return i, nil
+}
+
+// parseRange parses an inclusive range "a..b".
+func parseRange(param string) (int64, int64, error) {
+	parts := strings.Split(param, "..")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%w: %q is not a range", ErrInvalidParam, param)
+	}
+	start, err := parseInt64(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseInt64(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("%w: %q is an empty range", ErrInvalidParam, param)
+	}
+	return start, end, nil
+}
@@ -0,0 +1,79 @@
+package check
+
+import "context"
+
+// StepCtx is the context-aware variant of Step, for validation steps which need to honor cancellation and
+// deadlines, i.e. looking up a cache. It follows the same rules as Step.
+type StepCtx func(ctx context.Context, target interface{}) error
+
+// Ctx adapts this Step into a StepCtx which ignores the context, so it can be used with ThatCtx.
+//
+//	check.ThatCtx(username, stringz.IsNotEmpty.Ctx(), usernameNotTaken)
+func (s Step) Ctx() StepCtx {
+	return func(_ context.Context, target interface{}) error {
+		return s(target)
+	}
+}
+
+// ErrFuncCtx is the context-aware variant of ErrFunc. It works with AnyErrCtx.
+type ErrFuncCtx func(ctx context.Context) error
+
+// Ctx adapts this ErrFunc into an ErrFuncCtx which ignores the context, so it can be used with AnyErrCtx.
+func (f ErrFunc) Ctx() ErrFuncCtx {
+	return func(_ context.Context) error {
+		return f()
+	}
+}
+
+// Err is the context-aware version of ErrFunc.Err.
+func (f ErrFuncCtx) Err(err error) ErrFuncCtx {
+	return func(ctx context.Context) error {
+		fe := f(ctx)
+		if fe == nil {
+			return nil
+		}
+		return err
+	}
+}
+
+// Path is the context-aware version of ErrFunc.Path.
+func (f ErrFuncCtx) Path(path string) ErrFuncCtx {
+	return func(ctx context.Context) error {
+		return WithPath(path, f(ctx))
+	}
+}
+
+// ThatCtx is the context-aware version of That. Before each StepCtx is performed, the context is checked, and the
+// context error is returned if the context is done.
+func ThatCtx(target interface{}, steps ...StepCtx) ErrFuncCtx {
+	return func(ctx context.Context) error {
+		for _, s := range steps {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := s(ctx, target); err != nil {
+				switch err {
+				case Skip:
+					return nil
+				default:
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// AnyErrCtx is the context-aware version of AnyErr. Before each ErrFuncCtx is invoked, the context is checked, and
+// the context error is returned if the context is done.
+func AnyErrCtx(ctx context.Context, ef ...ErrFuncCtx) error {
+	for _, it := range ef {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := it(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestThatCtx(t *testing.T) {
+	var calls int
+	countStep := check.StepCtx(func(ctx context.Context, target interface{}) error {
+		calls++
+		return nil
+	})
+
+	assert.NoError(t, check.ThatCtx("foo", stringz.IsNotEmpty.Ctx(), countStep)(context.Background()))
+	assert.Equal(t, 1, calls)
+
+	err := check.ThatCtx("", stringz.IsNotEmpty.Ctx(), countStep)(context.Background())
+	assert.True(t, errors.Is(err, stringz.ErrIsNotEmpty))
+	assert.Equal(t, 1, calls)
+
+	assert.NoError(t, check.ThatCtx("", check.Optional.Ctx(), stringz.IsNotEmpty.Ctx())(context.Background()))
+}
+
+func TestThatCtx_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancelStep := check.StepCtx(func(ctx context.Context, target interface{}) error {
+		cancel()
+		return nil
+	})
+
+	err := check.ThatCtx("foo", cancelStep, wrongStep.Ctx())(ctx)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestAnyErrCtx(t *testing.T) {
+	assert.NoError(t, check.AnyErrCtx(context.Background(),
+		check.ThatCtx("foo", correctStep.Ctx()),
+		check.That("foo", correctStep).Ctx(),
+	))
+
+	var customErr = errors.New("customErr")
+	assert.Equal(t, &check.PathError{Path: "name", Err: customErr}, check.AnyErrCtx(context.Background(),
+		check.ThatCtx("foo", correctStep.Ctx()),
+		check.ThatCtx("foo", wrongStep.Ctx()).Err(customErr).Path("name"),
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, check.AnyErrCtx(ctx, check.ThatCtx("foo", correctStep.Ctx())))
+}
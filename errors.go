@@ -0,0 +1,162 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Errors is an aggregate of errors collected from multiple validations. It is returned by AllErr, and can be
+// iterated as a regular slice.
+//
+// Errors works with errors.Is and errors.As by testing against each member error in order.
+type Errors []error
+
+// Error joins the messages of all member errors.
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, it := range e {
+		messages = append(messages, it.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether any member error matches the target with errors.Is.
+func (e Errors) Is(target error) bool {
+	for _, it := range e {
+		if errors.Is(it, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first member error that matches the target with errors.As, and sets target to that error value.
+func (e Errors) As(target interface{}) bool {
+	for _, it := range e {
+		if errors.As(it, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Params are the parameters of the validation rule that produced an Error, keyed by parameter name.
+type Params map[string]interface{}
+
+// Error is the structured error returned by the built-in Step. Besides the documented sentinel error, it keeps a
+// stable machine-readable code, the offending value and the parameters of the rule, so that clients can render
+// messages like "must be between 1 and 10". Error matches the sentinel error with errors.Is.
+//
+//	var e *check.Error
+//	if errors.As(err, &e) {
+//		fmt.Println(e.Code, e.Value, e.Params)	// int64.in_range 12 map[end:10 start:1]
+//	}
+type Error struct {
+	// Code is the stable code of the failed rule, i.e. "int64.in_range".
+	Code string
+	// Value is the offending value.
+	Value interface{}
+	// Params are the parameters of the failed rule, i.e. {"start": 1, "end": 10}. It may be nil.
+	Params Params
+	// Err is the sentinel error of the failed rule, i.e. int64z.ErrInRange.
+	Err error
+}
+
+// NewError creates an Error for the sentinel error, with the code, the offending value and the rule parameters.
+func NewError(err error, code string, value interface{}, params Params) *Error {
+	return &Error{Code: code, Value: value, Params: params, Err: err}
+}
+
+// Error returns the message of the sentinel error.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the sentinel error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// CodeUnexpectedType is the code of TypeError, for message rendering.
+const CodeUnexpectedType = "check.unexpected_type"
+
+// TypeError is the error returned when the validated target is not of the expected type. It carries the name of
+// the expected and the actual type, and matches ErrUnexpectedType with errors.Is.
+type TypeError struct {
+	Expected string
+	Actual   string
+}
+
+// NewTypeError creates a TypeError which expects the target to be of type T.
+func NewTypeError[T any](target interface{}) *TypeError {
+	return &TypeError{
+		Expected: fmt.Sprintf("%T", (*T)(nil))[1:],
+		Actual:   fmt.Sprintf("%T", target),
+	}
+}
+
+// Error reports the expected and the actual type.
+func (e *TypeError) Error() string {
+	return ErrUnexpectedType.Error() + ": expected " + e.Expected + ", got " + e.Actual
+}
+
+// Unwrap returns ErrUnexpectedType.
+func (e *TypeError) Unwrap() error {
+	return ErrUnexpectedType
+}
+
+// PathError is an error which records the path to the validated target that caused the error, for
+// instance, "user.addresses[2].zip". It is created by WithPath and WithIndex, and unwraps to the original error.
+type PathError struct {
+	Path string
+	Err  error
+}
+
+// Error prefixes the path to the message of the original error.
+func (e *PathError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// WithPath annotates the error with the path of the validated target. If the error already carries a path,
+// the given path is prepended to it, so that paths compose from the outermost target to the innermost one. If the
+// error is Errors, each member error is annotated. A nil error or Skip is returned as is.
+//
+//	check.WithPath("user", check.WithPath("zip", err))	// path is "user.zip"
+//	check.WithPath("tags", check.WithIndex(2, err))	// path is "tags[2]"
+func WithPath(path string, err error) error {
+	if err == nil || err == Skip || len(path) == 0 {
+		return err
+	}
+
+	switch e := err.(type) {
+	case Errors:
+		errs := make(Errors, 0, len(e))
+		for _, it := range e {
+			errs = append(errs, WithPath(path, it))
+		}
+		return errs
+	case *PathError:
+		return &PathError{Path: joinPath(path, e.Path), Err: e.Err}
+	default:
+		return &PathError{Path: path, Err: err}
+	}
+}
+
+// WithIndex is like WithPath, but annotates the error with an index of the slice element, for instance, "[2]".
+func WithIndex(index int, err error) error {
+	return WithPath("["+strconv.Itoa(index)+"]", err)
+}
+
+func joinPath(outer string, inner string) string {
+	if strings.HasPrefix(inner, "[") {
+		return outer + inner
+	}
+	return outer + "." + inner
+}
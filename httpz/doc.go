@@ -0,0 +1,14 @@
+// Package httpz contains check.Step implementation related to *http.Request.
+//
+// Steps extract a value from the request, i.e. a query parameter, and apply the supplied string steps to it.
+// Errors are annotated with the path to the value, i.e. "query.page", "header.X-Request-Id", "form.name" or
+// "path[1]". Numbers are validated by combining with int64z.Parse.
+//
+//	err := httpz.Validate(r,
+//		httpz.Query("page", int64z.Parse(int64z.InRange(1, 101))),
+//		httpz.Header("X-Request-Id", stringz.IsNotEmpty),
+//	)
+//
+// Middleware runs the steps before the handler, and short-circuits with a 400 problem details response (see the
+// problem package) on failure. MiddlewareWith does the same with a custom problem.Renderer.
+package httpz
@@ -0,0 +1,62 @@
+package httpz
+
+import (
+	"github.com/imulab/check"
+	"net/http"
+	"strings"
+)
+
+// Query returns a check.Step which applies the steps to the first value of the query parameter like check.That.
+// An absent parameter is validated as an empty string.
+func Query(name string, steps ...check.Step) check.Step {
+	return request(func(r *http.Request) error {
+		return check.That(r.URL.Query().Get(name), steps...).Path(name).Path("query")()
+	})
+}
+
+// Header returns a check.Step which applies the steps to the first value of the header like check.That. An absent
+// header is validated as an empty string.
+func Header(name string, steps ...check.Step) check.Step {
+	name = http.CanonicalHeaderKey(name)
+	return request(func(r *http.Request) error {
+		return check.That(r.Header.Get(name), steps...).Path(name).Path("header")()
+	})
+}
+
+// Form returns a check.Step which applies the steps to the first value of the field in the form body like
+// check.That. An absent field is validated as an empty string. If the form body cannot be parsed, the parse error
+// is returned.
+func Form(name string, steps ...check.Step) check.Step {
+	return request(func(r *http.Request) error {
+		if err := r.ParseForm(); err != nil {
+			return err
+		}
+		return check.That(r.PostForm.Get(name), steps...).Path(name).Path("form")()
+	})
+}
+
+// Segment returns a check.Step which applies the steps to the path segment at the index like check.That. Segments
+// are separated by "/", with leading and trailing "/" ignored, so that the index of "users" in "/users/42" is 0.
+// An absent segment is validated as an empty string.
+func Segment(index int, steps ...check.Step) check.Step {
+	return request(func(r *http.Request) error {
+		var segment string
+		if segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/"); index >= 0 && index < len(segments) {
+			segment = segments[index]
+		}
+		return check.WithPath("path", check.WithIndex(index, check.That(segment, steps...)()))
+	})
+}
+
+// Validate performs every check.Step on the request independently, and collects all failures like check.AllErr.
+func Validate(r *http.Request, steps ...check.Step) error {
+	ef := make([]check.ErrFunc, 0, len(steps))
+	for _, it := range steps {
+		ef = append(ef, check.That(r, it))
+	}
+	return check.AllErr(ef...)
+}
+
+func request(f func(r *http.Request) error) check.Step {
+	return check.StepOf[*http.Request](f).Step()
+}
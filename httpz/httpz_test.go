@@ -0,0 +1,93 @@
+package httpz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/httpz"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	cases := []struct {
+		name   string
+		target string
+		err    error
+	}{
+		{name: "valid", target: "/users?page=2"},
+		{name: "not int", target: "/users?page=two", err: int64z.ErrParse},
+		{name: "absent", target: "/users", err: int64z.ErrParse},
+		{name: "out of range", target: "/users?page=200", err: int64z.ErrInRange},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, c.target, nil)
+			err := check.That(r, httpz.Query("page", int64z.Parse(int64z.InRange(1, 101))))()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assertPath(t, "query.page", c.err, err)
+			}
+		})
+	}
+}
+
+func TestHeader(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	assertPath(t, "header.X-Request-Id", stringz.ErrIsNotEmpty, check.That(r, httpz.Header("x-request-id", stringz.IsNotEmpty))())
+
+	r.Header.Set("X-Request-Id", "42")
+	assert.NoError(t, check.That(r, httpz.Header("x-request-id", stringz.IsNotEmpty))())
+}
+
+func TestForm(t *testing.T) {
+	body := url.Values{"name": {"foo"}, "role": {"guest"}}.Encode()
+	r := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	assert.NoError(t, check.That(r, httpz.Form("name", stringz.IsNotEmpty))())
+	assertPath(t, "form.role", stringz.ErrIn, check.That(r, httpz.Form("role", stringz.In("admin", "member")))())
+}
+
+func TestSegment(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/users/abc/", nil)
+
+	assert.NoError(t, check.That(r, httpz.Segment(0, stringz.Is("users")))())
+	assertPath(t, "path[1]", int64z.ErrParse, check.That(r, httpz.Segment(1, int64z.Parse()))())
+	assertPath(t, "path[2]", stringz.ErrIsNotEmpty, check.That(r, httpz.Segment(2, stringz.IsNotEmpty))())
+}
+
+func TestValidate(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/users?page=0", nil)
+	err := httpz.Validate(r,
+		httpz.Query("page", int64z.Parse(int64z.Positive)),
+		httpz.Query("sort", stringz.IsNotEmpty),
+		httpz.Header("Accept", check.Optional),
+	)
+
+	errs, ok := err.(check.Errors)
+	if assert.True(t, ok) && assert.Len(t, errs, 2) {
+		assertPath(t, "query.page", int64z.ErrGreaterThan, errs[0])
+		assertPath(t, "query.sort", stringz.ErrIsNotEmpty, errs[1])
+	}
+}
+
+func TestUnexpectedType(t *testing.T) {
+	err := check.That("/users", httpz.Query("page", stringz.IsNotEmpty))()
+	assert.True(t, errors.Is(err, check.ErrUnexpectedType))
+}
+
+func assertPath(t *testing.T, path string, expect error, err error) {
+	var pathErr *check.PathError
+	if assert.True(t, errors.As(err, &pathErr)) {
+		assert.Equal(t, path, pathErr.Path)
+		assert.True(t, errors.Is(err, expect))
+	}
+}
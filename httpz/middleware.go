@@ -0,0 +1,72 @@
+package httpz
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/problem"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Middleware returns a middleware which validates the request with the steps (see Validate) before calling the
+// next handler. On failure, the next handler is not called, and the errors are written with problem.Default in
+// the locales of the Accept-Language header.
+//
+//	mux.Handle("/users", httpz.Middleware(
+//		httpz.Query("page", int64z.Parse(int64z.InRange(1, 101))),
+//	)(usersHandler))
+func Middleware(steps ...check.Step) func(http.Handler) http.Handler {
+	return MiddlewareWith(problem.Default, steps...)
+}
+
+// MiddlewareWith is Middleware writing the errors with the problem.Renderer instead, i.e. to respond with a custom
+// problem type, status or message catalog.
+//
+//	renderer := &problem.Renderer{Status: http.StatusUnprocessableEntity, Messages: catalog.NewRenderer(french)}
+//	mux.Handle("/users", httpz.MiddlewareWith(renderer, steps...)(usersHandler))
+func MiddlewareWith(renderer *problem.Renderer, steps ...check.Step) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := Validate(r, steps...); err != nil {
+				_ = renderer.Write(w, err, Locales(r)...)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Locales returns the locales of the Accept-Language header, in order of preference. The wildcard "*" and locales
+// with zero quality are excluded.
+func Locales(r *http.Request) []string {
+	type weighted struct {
+		locale  string
+		quality float64
+	}
+
+	var candidates []weighted
+	for _, it := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		parts := strings.Split(strings.TrimSpace(it), ";")
+		w := weighted{locale: strings.TrimSpace(parts[0]), quality: 1}
+		for _, param := range parts[1:] {
+			if q := strings.TrimSpace(param); strings.HasPrefix(q, "q=") {
+				if f, err := strconv.ParseFloat(q[2:], 64); err == nil {
+					w.quality = f
+				}
+			}
+		}
+		if len(w.locale) > 0 && w.locale != "*" && w.quality > 0 {
+			candidates = append(candidates, w)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	locales := make([]string, 0, len(candidates))
+	for _, it := range candidates {
+		locales = append(locales, it.locale)
+	}
+	return locales
+}
@@ -0,0 +1,89 @@
+package httpz_test
+
+import (
+	"github.com/imulab/check/catalog"
+	"github.com/imulab/check/httpz"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/problem"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	handler := httpz.Middleware(
+		httpz.Query("page", int64z.Parse(int64z.InRange(1, 101))),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users?page=2", nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users?page=0", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, problem.ContentType, rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"errors": [{
+			"path": "query.page",
+			"code": "int64.in_range",
+			"message": "must be at least 1 and less than 101",
+			"params": {"start": 1, "end": 101}
+		}]
+	}`, rec.Body.String())
+}
+
+func TestMiddlewareWith(t *testing.T) {
+	french := catalog.New()
+	french.Set("fr", int64z.CodeInRange, "doit être entre {start} et {end}")
+	renderer := &problem.Renderer{Status: http.StatusUnprocessableEntity, Messages: catalog.NewRenderer(french)}
+
+	handler := httpz.MiddlewareWith(renderer,
+		httpz.Query("page", int64z.Parse(int64z.InRange(1, 101))),
+	)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/users?page=0", nil)
+	req.Header.Set("Accept-Language", "fr-CA, en;q=0.5")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"errors": [{
+			"path": "query.page",
+			"code": "int64.in_range",
+			"message": "doit être entre 1 et 101",
+			"params": {"start": 1, "end": 101}
+		}]
+	}`, rec.Body.String())
+}
+
+func TestLocales(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		expect []string
+	}{
+		{name: "absent", header: "", expect: []string{}},
+		{name: "single", header: "fr-CA", expect: []string{"fr-CA"}},
+		{name: "weighted", header: "en;q=0.5, fr-CA, fr;q=0.8, *;q=0.1, de;q=0", expect: []string{"fr-CA", "fr", "en"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Language", c.header)
+			assert.Equal(t, c.expect, httpz.Locales(r))
+		})
+	}
+}
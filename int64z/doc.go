@@ -1,2 +1,5 @@
-// Package int64z contains check.Step implementation related to int64 types.
+// Package int64z contains check.Step implementation related to int64 types. For other integer and floating point
+// types, see numz.
+//
+// Failures carry the bounds of the rule, i.e. the start and end of InRange, in the Params of *check.Error.
 package int64z
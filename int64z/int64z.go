@@ -3,6 +3,8 @@ package int64z
 import (
 	"errors"
 	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+	"strconv"
 )
 
 var (
@@ -10,96 +12,115 @@ var (
 	ErrNotEqual             = errors.New("int64 value equals unexpected value")
 	ErrInRange              = errors.New("int64 value is not in range")
 	ErrGreaterThan          = errors.New("int64 value is not greater than expected value")
-	ErrLessThan             = errors.New("int64 value is not ")
+	ErrLessThan             = errors.New("int64 value is not less than expected value")
 	ErrGreaterThanOrEqualTo = errors.New("int64 value is less than expected value")
 	ErrLessThanOrEqualTo    = errors.New("int64 value is greater than expected value")
+	ErrParse                = errors.New("string is not a decimal int64 value")
+	ErrMultipleOf           = errors.New("int64 value is not a multiple of expected value")
+	ErrStepFrom             = errors.New("int64 value is not on expected step")
+)
+
+// Codes of the check.Error returned by the check.Step in this package.
+const (
+	CodeEquals               = "int64.equals"
+	CodeNotEqual             = "int64.not_equal"
+	CodeInRange              = "int64.in_range"
+	CodeGreaterThan          = "int64.greater_than"
+	CodeLessThan             = "int64.less_than"
+	CodeGreaterThanOrEqualTo = "int64.greater_than_or_equal_to"
+	CodeLessThanOrEqualTo    = "int64.less_than_or_equal_to"
+	CodeParse                = "int64.parse"
+	CodeWithin               = "int64.within"
+	CodeMultipleOf           = "int64.multiple_of"
+	CodeStepFrom             = "int64.step_from"
 )
 
 var (
 	// Zero is a convenient check.Step to check equality to 0
-	Zero = Equals(0)
+	Zero = Of[int64]().Zero.Step()
 	// Positive is a convenient check.Step to check greater than 0
-	Positive = GreaterThan(0)
+	Positive = Of[int64]().Positive.Step()
 	// Negative is a convenient check.Step to check less than 0
-	Negative = LessThan(0)
+	Negative = Of[int64]().Negative.Step()
 	// NonPositive is a convenient check.Step to check less than or equal to 0
-	NonPositive = LessThanOrEqualTo(0)
+	NonPositive = Of[int64]().NonPositive.Step()
 	// NonNegative is a convenient check.Step to check greater than or equal to 0
-	NonNegative = GreaterThanOrEqualTo(0)
+	NonNegative = Of[int64]().NonNegative.Step()
 )
 
 // Equals returns a check.Step that check equality of target and expected value, or returns ErrEquals.
 func Equals(expected int64) check.Step {
-	return func(target interface{}) error {
-		if expected == target.(int64) {
-			return nil
-		}
-		return ErrEquals
-	}
+	return Of[int64]().Equals(expected).Step()
 }
 
 // NotEqual returns a check.Step to check inequality to target to the value, or returns ErrNotEqual.
 func NotEqual(unexpected int64) check.Step {
-	return func(target interface{}) error {
-		if unexpected != target.(int64) {
-			return nil
-		}
-		return ErrNotEqual
-	}
+	return Of[int64]().NotEqual(unexpected).Step()
 }
 
 // InRange returns a check.Step that check the int64 target value is in the given range, specified by an
 // inclusive start value and an exclusive end value, or returns ErrInRange.
 func InRange(startInclusive int64, endExclusive int64) check.Step {
-	return func(target interface{}) error {
-		i := target.(int64)
-		if startInclusive <= i && i < endExclusive {
-			return nil
-		}
-		return ErrInRange
-	}
+	return Of[int64]().InRange(startInclusive, endExclusive).Step()
+}
+
+// Within returns a check.Step that check the int64 target value is in the rangez.Range, or returns ErrInRange.
+// Unlike InRange, the range can be closed, open or unbounded on either side.
+//
+//	int64z.Within(rangez.Closed[int64](1, 100))
+func Within(r rangez.Range[int64]) check.Step {
+	return Of[int64]().Within(r).Step()
 }
 
 // GreaterThan returns a check.Step that check the int64 target value is greater than the expected bound value,
 // or returns a ErrGreaterThan
 func GreaterThan(bound int64) check.Step {
-	return func(target interface{}) error {
-		if target.(int64) > bound {
-			return nil
-		}
-		return ErrGreaterThan
-	}
+	return Of[int64]().GreaterThan(bound).Step()
 }
 
 // LessThan returns a check.Step that check the int64 target value is less than the expected bound value,
 // or returns a ErrLessThan
 func LessThan(bound int64) check.Step {
-	return func(target interface{}) error {
-		if target.(int64) < bound {
-			return nil
-		}
-		return ErrLessThan
-	}
+	return Of[int64]().LessThan(bound).Step()
 }
 
 // GreaterThanOrEqualTo returns a check.Step that check the int64 target value is greater than or equal to
 // the expected bound value, or returns a ErrGreaterThanOrEqualTo
 func GreaterThanOrEqualTo(bound int64) check.Step {
-	return func(target interface{}) error {
-		if target.(int64) >= bound {
-			return nil
-		}
-		return ErrGreaterThanOrEqualTo
-	}
+	return Of[int64]().GreaterThanOrEqualTo(bound).Step()
 }
 
 // LessThanOrEqualTo returns a check.Step that check the int64 target value is less than or equal to
 // the expected bound value, or returns a LessThanOrEqualTo.
 func LessThanOrEqualTo(bound int64) check.Step {
-	return func(target interface{}) error {
-		if target.(int64) <= bound {
-			return nil
+	return Of[int64]().LessThanOrEqualTo(bound).Step()
+}
+
+// MultipleOf returns a check.Step that check the int64 target value is a multiple of the divisor, or returns
+// ErrMultipleOf.
+//
+//	int64z.MultipleOf(5)	// amount in cents is a multiple of 5 cents
+func MultipleOf(divisor int64) check.Step {
+	return Of[int64]().MultipleOf(divisor).Step()
+}
+
+// StepFrom returns a check.Step that check the int64 target value is one of base, base+step, base+2*step and so
+// on, or returns ErrStepFrom.
+func StepFrom(base int64, step int64) check.Step {
+	return Of[int64]().StepFrom(base, step).Step()
+}
+
+// Parse returns a check.Step that parses the string target as a decimal int64, and applies the int64 steps to the
+// parsed value like check.That, or returns ErrParse if the target cannot be parsed. It is useful to validate
+// numbers in text, i.e. query parameters.
+//
+//	check.That(page, int64z.Parse(int64z.InRange(1, 101)))
+func Parse(steps ...check.Step) check.Step {
+	return check.StepOf[string](func(target string) error {
+		i, err := strconv.ParseInt(target, 10, 64)
+		if err != nil {
+			return check.NewError(ErrParse, CodeParse, target, nil)
 		}
-		return ErrLessThanOrEqualTo
-	}
+		return check.That(i, steps...)()
+	}).Step()
 }
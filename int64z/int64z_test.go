@@ -1,6 +1,7 @@
 package int64z_test
 
 import (
+	"errors"
 	"github.com/imulab/check"
 	"github.com/imulab/check/int64z"
 	"github.com/stretchr/testify/assert"
@@ -24,7 +25,7 @@ func TestEquals(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
@@ -47,7 +48,7 @@ func TestNotEqual(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
@@ -74,7 +75,7 @@ func TestInRage(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
@@ -98,7 +99,7 @@ func TestGreaterThan(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
@@ -122,7 +123,7 @@ func TestGreaterThanOrEqualTo(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
@@ -146,7 +147,7 @@ func TestLessThan(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
@@ -170,7 +171,47 @@ func TestLessThanOrEqualTo(t *testing.T) {
 			if c.err == nil {
 				assert.NoError(t, err)
 			} else {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestUnexpectedType(t *testing.T) {
+	err := check.That(1, int64z.Positive)()
+	assert.True(t, errors.Is(err, check.ErrUnexpectedType))
+	assert.Equal(t, &check.TypeError{Expected: "int64", Actual: "int"}, err)
+}
+
+func TestInRange_Error(t *testing.T) {
+	err := check.That(int64(12), int64z.InRange(1, 10))()
+	assert.Equal(t, &check.Error{
+		Code:   int64z.CodeInRange,
+		Value:  int64(12),
+		Params: check.Params{"start": int64(1), "end": int64(10)},
+		Err:    int64z.ErrInRange,
+	}, err)
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name   string
+		target string
+		err    error
+	}{
+		{name: "parsed", target: "5"},
+		{name: "not int", target: "five", err: int64z.ErrParse},
+		{name: "empty", target: "", err: int64z.ErrParse},
+		{name: "out of range", target: "15", err: int64z.ErrInRange},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(c.target, int64z.Parse(int64z.InRange(1, 10)))()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
 			}
 		})
 	}
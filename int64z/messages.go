@@ -0,0 +1,23 @@
+package int64z
+
+import "github.com/imulab/check/catalog"
+
+// Messages contains the English message templates of the int64 codes, which are registered as built-in messages
+// of catalog on init.
+var Messages = map[string]string{
+	CodeEquals:               "must equal {expected}",
+	CodeNotEqual:             "must not equal {unexpected}",
+	CodeInRange:              "must be at least {start} and less than {end}",
+	CodeGreaterThan:          "must be greater than {bound}",
+	CodeLessThan:             "must be less than {bound}",
+	CodeGreaterThanOrEqualTo: "must be greater than or equal to {bound}",
+	CodeLessThanOrEqualTo:    "must be less than or equal to {bound}",
+	CodeWithin:               "must be within {range}",
+	CodeMultipleOf:           "must be a multiple of {divisor}",
+	CodeStepFrom:             "must be {base} plus a multiple of {step}",
+	CodeParse:                "must be an integer",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
@@ -0,0 +1,147 @@
+package int64z
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/internal/arith"
+	"github.com/imulab/check/rangez"
+)
+
+// Of returns the namespace for all check.StepOf which assumes the target is of int64 type I, including named
+// int64 types.
+//
+//	type Amount int64
+//	check.ThatOf(amount, int64z.Of[Amount]().Positive)
+func Of[I ~int64]() Typed[I] {
+	t := Typed[I]{}
+	t.Zero = t.Equals(0)
+	t.Positive = t.GreaterThan(0)
+	t.Negative = t.LessThan(0)
+	t.NonPositive = t.LessThanOrEqualTo(0)
+	t.NonNegative = t.GreaterThanOrEqualTo(0)
+	return t
+}
+
+// Typed is the namespace for check.StepOf with respect to int64 type I. Use Of to obtain an instance.
+type Typed[I ~int64] struct {
+	// Zero is a convenient check.StepOf to check equality to 0
+	Zero check.StepOf[I]
+	// Positive is a convenient check.StepOf to check greater than 0
+	Positive check.StepOf[I]
+	// Negative is a convenient check.StepOf to check less than 0
+	Negative check.StepOf[I]
+	// NonPositive is a convenient check.StepOf to check less than or equal to 0
+	NonPositive check.StepOf[I]
+	// NonNegative is a convenient check.StepOf to check greater than or equal to 0
+	NonNegative check.StepOf[I]
+}
+
+// Equals returns a check.StepOf that check equality of target and expected value, or returns ErrEquals.
+func (Typed[I]) Equals(expected I) check.StepOf[I] {
+	return func(target I) error {
+		if expected == target {
+			return nil
+		}
+		return check.NewError(ErrEquals, CodeEquals, target, check.Params{"expected": expected})
+	}
+}
+
+// NotEqual returns a check.StepOf to check inequality to target to the value, or returns ErrNotEqual.
+func (Typed[I]) NotEqual(unexpected I) check.StepOf[I] {
+	return func(target I) error {
+		if unexpected != target {
+			return nil
+		}
+		return check.NewError(ErrNotEqual, CodeNotEqual, target, check.Params{"unexpected": unexpected})
+	}
+}
+
+// InRange returns a check.StepOf that check the target value is in the given range, specified by an
+// inclusive start value and an exclusive end value, or returns ErrInRange.
+func (Typed[I]) InRange(startInclusive I, endExclusive I) check.StepOf[I] {
+	return func(target I) error {
+		if startInclusive <= target && target < endExclusive {
+			return nil
+		}
+		return check.NewError(ErrInRange, CodeInRange, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// Within returns a check.StepOf that check the target value is in the rangez.Range, or returns ErrInRange with
+// the "range" parameter.
+func (Typed[I]) Within(r rangez.Range[I]) check.StepOf[I] {
+	return func(target I) error {
+		if r.Contains(target) {
+			return nil
+		}
+		return check.NewError(ErrInRange, CodeWithin, target, check.Params{"range": r})
+	}
+}
+
+// GreaterThan returns a check.StepOf that check the target value is greater than the expected bound value,
+// or returns a ErrGreaterThan
+func (Typed[I]) GreaterThan(bound I) check.StepOf[I] {
+	return func(target I) error {
+		if target > bound {
+			return nil
+		}
+		return check.NewError(ErrGreaterThan, CodeGreaterThan, target, check.Params{"bound": bound})
+	}
+}
+
+// LessThan returns a check.StepOf that check the target value is less than the expected bound value,
+// or returns a ErrLessThan
+func (Typed[I]) LessThan(bound I) check.StepOf[I] {
+	return func(target I) error {
+		if target < bound {
+			return nil
+		}
+		return check.NewError(ErrLessThan, CodeLessThan, target, check.Params{"bound": bound})
+	}
+}
+
+// GreaterThanOrEqualTo returns a check.StepOf that check the target value is greater than or equal to
+// the expected bound value, or returns a ErrGreaterThanOrEqualTo
+func (Typed[I]) GreaterThanOrEqualTo(bound I) check.StepOf[I] {
+	return func(target I) error {
+		if target >= bound {
+			return nil
+		}
+		return check.NewError(ErrGreaterThanOrEqualTo, CodeGreaterThanOrEqualTo, target, check.Params{"bound": bound})
+	}
+}
+
+// LessThanOrEqualTo returns a check.StepOf that check the target value is less than or equal to
+// the expected bound value, or returns a LessThanOrEqualTo.
+func (Typed[I]) LessThanOrEqualTo(bound I) check.StepOf[I] {
+	return func(target I) error {
+		if target <= bound {
+			return nil
+		}
+		return check.NewError(ErrLessThanOrEqualTo, CodeLessThanOrEqualTo, target, check.Params{"bound": bound})
+	}
+}
+
+// MultipleOf returns a check.StepOf that check the target value is a multiple of the divisor, or returns
+// ErrMultipleOf. Zero is the only multiple of zero.
+func (Typed[I]) MultipleOf(divisor I) check.StepOf[I] {
+	return func(target I) error {
+		if arith.MultipleOf(target, divisor) {
+			return nil
+		}
+		return check.NewError(ErrMultipleOf, CodeMultipleOf, target, check.Params{"divisor": divisor})
+	}
+}
+
+// StepFrom returns a check.StepOf that check the target value is one of base, base+step, base+2*step and so on,
+// or returns ErrStepFrom. A negative step counts downwards from base.
+func (Typed[I]) StepFrom(base I, step I) check.StepOf[I] {
+	return func(target I) error {
+		if arith.StepFrom(target, base, step) {
+			return nil
+		}
+		return check.NewError(ErrStepFrom, CodeStepFrom, target, check.Params{"base": base, "step": step})
+	}
+}
@@ -0,0 +1,45 @@
+package int64z_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/rangez"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type amount int64
+
+func TestOf(t *testing.T) {
+	cases := []struct {
+		name   string
+		target amount
+		step   check.StepOf[amount]
+		err    error
+	}{
+		{name: "positive", target: 1, step: int64z.Of[amount]().Positive},
+		{name: "not positive", target: 0, step: int64z.Of[amount]().Positive, err: int64z.ErrGreaterThan},
+		{name: "in range", target: 5, step: int64z.Of[amount]().InRange(1, 10)},
+		{name: "not in range", target: 10, step: int64z.Of[amount]().InRange(1, 10), err: int64z.ErrInRange},
+		{name: "within closed", target: 10, step: int64z.Of[amount]().Within(rangez.Closed[amount](1, 10))},
+		{name: "not within open", target: 10, step: int64z.Of[amount]().Within(rangez.Open[amount](1, 10)), err: int64z.ErrInRange},
+		{name: "multiple of", target: 25, step: int64z.Of[amount]().MultipleOf(5)},
+		{name: "not multiple of", target: 26, step: int64z.Of[amount]().MultipleOf(5), err: int64z.ErrMultipleOf},
+		{name: "step from", target: 40, step: int64z.Of[amount]().StepFrom(10, 10)},
+		{name: "not step from", target: 45, step: int64z.Of[amount]().StepFrom(10, 10), err: int64z.ErrStepFrom},
+		{name: "step from downwards", target: -20, step: int64z.Of[amount]().StepFrom(10, -10)},
+		{name: "within unbounded", target: -10, step: int64z.Of[amount]().Within(rangez.AtMost[amount](0))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
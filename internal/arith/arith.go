@@ -0,0 +1,37 @@
+// Package arith holds the integer arithmetic shared by int64z and numz, so that the overflow-sensitive parts live
+// in one place.
+package arith
+
+import "github.com/imulab/check/internal/constraints"
+
+// MultipleOf reports whether x is a multiple of divisor. Zero is a multiple of everything, and the only multiple
+// of zero.
+func MultipleOf[I constraints.Integer](x I, divisor I) bool {
+	if divisor == 0 {
+		return x == 0
+	}
+	return x%divisor == 0
+}
+
+// StepFrom reports whether x is one of base, base+step, base+2*step and so on. A negative step counts downwards
+// from base.
+func StepFrom[I constraints.Integer](x I, base I, step I) bool {
+	switch {
+	case step == 0:
+		return x == base
+	case step > 0:
+		return x >= base && floorMod(x, step) == floorMod(base, step)
+	default:
+		return x <= base && floorMod(x, step) == floorMod(base, step)
+	}
+}
+
+// floorMod returns the remainder of x divided by m, with the sign of m. Comparing remainders rather than taking
+// x-base keeps StepFrom from overflowing near the limits of I.
+func floorMod[I constraints.Integer](x I, m I) I {
+	r := x % m
+	if r != 0 && (r < 0) != (m < 0) {
+		r += m
+	}
+	return r
+}
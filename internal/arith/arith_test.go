@@ -0,0 +1,61 @@
+package arith_test
+
+import (
+	"github.com/imulab/check/internal/arith"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestMultipleOf(t *testing.T) {
+	cases := []struct {
+		name    string
+		x       int64
+		divisor int64
+		expect  bool
+	}{
+		{name: "multiple", x: 12, divisor: 4, expect: true},
+		{name: "not multiple", x: 13, divisor: 4},
+		{name: "negative", x: -12, divisor: 4, expect: true},
+		{name: "zero of zero", x: 0, divisor: 0, expect: true},
+		{name: "non-zero of zero", x: 3, divisor: 0},
+		{name: "min of minus one", x: math.MinInt64, divisor: -1, expect: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, arith.MultipleOf(c.x, c.divisor))
+		})
+	}
+}
+
+func TestStepFrom(t *testing.T) {
+	cases := []struct {
+		name   string
+		x      int64
+		base   int64
+		step   int64
+		expect bool
+	}{
+		{name: "on step", x: 30, base: 10, step: 10, expect: true},
+		{name: "off step", x: 35, base: 10, step: 10},
+		{name: "below base", x: 0, base: 10, step: 10},
+		{name: "negative base", x: 2, base: -3, step: 5, expect: true},
+		{name: "downwards", x: -10, base: 10, step: -10, expect: true},
+		{name: "above base downwards", x: 20, base: 10, step: -10},
+		{name: "zero step", x: 10, base: 10, expect: true},
+		{name: "zero step off base", x: 11, base: 10},
+		{name: "span overflows", x: math.MaxInt64, base: math.MinInt64 + 1, step: 2, expect: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, arith.StepFrom(c.x, c.base, c.step))
+		})
+	}
+}
+
+func TestStepFrom_Unsigned(t *testing.T) {
+	assert.True(t, arith.StepFrom[uint8](250, 10, 10))
+	assert.False(t, arith.StepFrom[uint8](255, 10, 10))
+}
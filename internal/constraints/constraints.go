@@ -0,0 +1,19 @@
+// Package constraints declares the type constraints shared by the validator packages, which re-export them under
+// their own names, i.e. numz.Integer and rangez.Ordered.
+package constraints
+
+// Integer is the constraint for all built-in integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is the constraint for all built-in floating point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Ordered is the constraint for types supporting the < operator.
+type Ordered interface {
+	Integer | Float | ~string
+}
@@ -0,0 +1,19 @@
+// Package jsonz decodes JSON payloads and validates the decoded value in one step.
+//
+// Validators are registered per type into a Registry. Decode errors, such as type mismatch, unknown field and
+// syntax errors, are mapped into *check.Error, so that clients see the same error model as validation failures. Type
+// mismatches are annotated with the path to the field, i.e. "addrs[0].zip". Unknown fields are annotated with the
+// key alone, as encoding/json does not report the enclosing object.
+//
+//	jsonz.Register(jsonz.Default, func(u *User) error {
+//		return check.AllErr(
+//			check.That(u.Name, stringz.IsNotEmpty).Path("name"),
+//		)
+//	})
+//
+//	var u User
+//	if err := jsonz.Decode(r.Body, &u); err != nil {
+//		problem.Write(w, err)
+//		return
+//	}
+package jsonz
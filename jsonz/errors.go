@@ -0,0 +1,18 @@
+package jsonz
+
+import "errors"
+
+var (
+	ErrEmpty        = errors.New("json payload is empty")
+	ErrSyntax       = errors.New("json payload is malformed")
+	ErrType         = errors.New("json value has unexpected type")
+	ErrUnknownField = errors.New("json field is unknown")
+)
+
+// Codes of the check.Error returned for decode errors.
+const (
+	CodeEmpty        = "json.empty"
+	CodeSyntax       = "json.syntax"
+	CodeType         = "json.type"
+	CodeUnknownField = "json.unknown_field"
+)
@@ -0,0 +1,169 @@
+package jsonz
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/imulab/check"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Default is the Registry used by Decode.
+var Default = &Registry{}
+
+// Registry holds the validators of decoded values by type. The zero value is ready to use, and is safe for
+// concurrent use.
+type Registry struct {
+	// AllowUnknownFields accepts object keys which do not match any field of the decoded struct. By default, they
+	// are reported as ErrUnknownField.
+	AllowUnknownFields bool
+
+	validators sync.Map // reflect.Type -> func(interface{}) error
+}
+
+// Register registers the validator for the decoded values of type T into the Registry, replacing any existing one.
+func Register[T any](r *Registry, validate func(v *T) error) {
+	r.validators.Store(reflect.TypeOf((*T)(nil)).Elem(), func(v interface{}) error {
+		return validate(v.(*T))
+	})
+}
+
+// Decode decodes with the Default Registry. See Registry.Decode.
+func Decode(r io.Reader, v interface{}) error {
+	return Default.Decode(r, v)
+}
+
+// Decode decodes a single JSON value from the reader into v, which must be a pointer, and validates it with the
+// validator registered for the type v points to. If there is no validator registered, the decoded value is not
+// validated.
+//
+// Decode errors are returned as *check.Error matching ErrEmpty, ErrSyntax, ErrType or ErrUnknownField. ErrType is
+// annotated with the path to the field, i.e. "addrs[0].zip", while ErrUnknownField only carries the unknown key as
+// its path, because encoding/json does not tell where the key is. Other errors, i.e. failing to read, are returned
+// as is. The value is not validated if it is not decoded.
+func (r *Registry) Decode(reader io.Reader, v interface{}) error {
+	dec := json.NewDecoder(reader)
+	if !r.AllowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+
+	if err := dec.Decode(v); err != nil {
+		return mapError(reflect.TypeOf(v), err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return check.NewError(ErrSyntax, CodeSyntax, nil, check.Params{"offset": dec.InputOffset()})
+	}
+
+	if validate, ok := r.validators.Load(reflect.TypeOf(v).Elem()); ok {
+		return validate.(func(interface{}) error)(v)
+	}
+	return nil
+}
+
+func mapError(t reflect.Type, err error) error {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	switch {
+	case err == io.EOF:
+		return check.NewError(ErrEmpty, CodeEmpty, nil, nil)
+	case err == io.ErrUnexpectedEOF:
+		return check.NewError(ErrSyntax, CodeSyntax, nil, nil)
+	case errors.As(err, &syntaxErr):
+		return check.NewError(ErrSyntax, CodeSyntax, nil, check.Params{"offset": syntaxErr.Offset})
+	case errors.As(err, &typeErr):
+		return withFieldPath(t, typeErr.Field, check.NewError(ErrType, CodeType, typeErr.Value, check.Params{
+			"expected": typeErr.Type.String(),
+			"actual":   typeErr.Value,
+		}))
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// encoding/json does not export a type for unknown field errors, nor tells where the field is, so only the
+		// key is known.
+		field, uerr := strconv.Unquote(strings.TrimPrefix(err.Error(), "json: unknown field "))
+		if uerr != nil {
+			return err
+		}
+		return check.WithPath(field, check.NewError(ErrUnknownField, CodeUnknownField, nil, check.Params{"field": field}))
+	default:
+		return err
+	}
+}
+
+// withFieldPath annotates the error with the dotted field path reported by encoding/json, i.e. "addrs.0.zip", in the
+// form of check.WithPath and check.WithIndex, i.e. "addrs[0].zip". The type t of the decoded value tells which
+// segments are indexes: a numeric segment is only an index into a slice or array, as it may as well be a map key.
+func withFieldPath(t reflect.Type, field string, err error) error {
+	if len(field) == 0 {
+		return err
+	}
+	segments := strings.Split(field, ".")
+	indexes := make([]bool, len(segments))
+	for i, segment := range segments {
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t == nil {
+			break
+		}
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			_, aerr := strconv.Atoi(segment)
+			indexes[i] = aerr == nil
+			t = t.Elem()
+		case reflect.Map:
+			t = t.Elem()
+		case reflect.Struct:
+			t = fieldType(t, segment)
+		default:
+			t = nil
+		}
+	}
+	for i := len(segments) - 1; i >= 0; i-- {
+		if indexes[i] {
+			index, _ := strconv.Atoi(segments[i])
+			err = check.WithIndex(index, err)
+		} else {
+			err = check.WithPath(segments[i], err)
+		}
+	}
+	return err
+}
+
+// fieldType returns the type of the struct field decoded from the JSON key name, looking into embedded structs for
+// promoted fields, or nil if there is no such field.
+func fieldType(t reflect.Type, name string) reflect.Type {
+	var folded reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		key := f.Name
+		if tagged := strings.Split(tag, ",")[0]; len(tagged) > 0 {
+			key = tagged
+		} else if f.Anonymous {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if promoted := fieldType(embedded, name); promoted != nil {
+					return promoted
+				}
+				continue
+			}
+		}
+		switch {
+		case key == name:
+			return f.Type
+		case folded == nil && strings.EqualFold(key, name):
+			folded = f.Type
+		}
+	}
+	return folded
+}
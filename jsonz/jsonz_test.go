@@ -0,0 +1,86 @@
+package jsonz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/jsonz"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type address struct {
+	Zip string `json:"zip"`
+}
+
+type user struct {
+	Name    string             `json:"name"`
+	Age     int64              `json:"age"`
+	Address address            `json:"address"`
+	Addrs   []address          `json:"addrs"`
+	Offices map[string]address `json:"offices"`
+}
+
+func TestRegistry_Decode(t *testing.T) {
+	registry := &jsonz.Registry{}
+	jsonz.Register(registry, func(u *user) error {
+		return check.AllErr(
+			check.That(u.Name, stringz.IsNotEmpty).Path("name"),
+			check.That(u.Address.Zip, stringz.HasLength(5)).Path("address.zip"),
+		)
+	})
+
+	cases := []struct {
+		name    string
+		payload string
+		path    string
+		err     error
+	}{
+		{name: "valid", payload: `{"name": "foo", "age": 18, "address": {"zip": "12345"}}`},
+		{name: "validation", payload: `{"name": "", "address": {"zip": "12345"}}`, path: "name", err: stringz.ErrIsNotEmpty},
+		{name: "nested validation", payload: `{"name": "foo", "address": {"zip": "1"}}`, path: "address.zip", err: stringz.ErrHasLength},
+		{name: "empty", payload: ``, err: jsonz.ErrEmpty},
+		{name: "syntax", payload: `{"name": "foo",}`, err: jsonz.ErrSyntax},
+		{name: "truncated", payload: `{"name": "foo"`, err: jsonz.ErrSyntax},
+		{name: "trailing data", payload: `{"name": "foo"} {}`, err: jsonz.ErrSyntax},
+		{name: "type mismatch", payload: `{"name": "foo", "age": "18"}`, path: "age", err: jsonz.ErrType},
+		{name: "nested type mismatch", payload: `{"name": "foo", "address": {"zip": 12345}}`, path: "address.zip", err: jsonz.ErrType},
+		{name: "element type mismatch", payload: `{"name": "foo", "addrs": [{"zip": "12345"}, {"zip": 1}]}`, path: "addrs[1].zip", err: jsonz.ErrType},
+		{name: "numeric key type mismatch", payload: `{"name": "foo", "offices": {"2024": {"zip": 1}}}`, path: "offices.2024.zip", err: jsonz.ErrType},
+		{name: "unknown field", payload: `{"name": "foo", "email": "foo@bar.com"}`, path: "email", err: jsonz.ErrUnknownField},
+		{name: "nested unknown field", payload: `{"name": "foo", "addrs": [{"zipp": "1"}]}`, path: "zipp", err: jsonz.ErrUnknownField},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var u user
+			err := registry.Decode(strings.NewReader(c.payload), &u)
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, errors.Is(err, c.err))
+			var pathErr *check.PathError
+			if len(c.path) > 0 && assert.True(t, errors.As(err, &pathErr)) {
+				assert.Equal(t, c.path, pathErr.Path)
+			}
+			var checkErr *check.Error
+			assert.True(t, errors.As(err, &checkErr))
+		})
+	}
+}
+
+func TestRegistry_Decode_AllowUnknownFields(t *testing.T) {
+	registry := &jsonz.Registry{AllowUnknownFields: true}
+
+	var u user
+	assert.NoError(t, registry.Decode(strings.NewReader(`{"name": "foo", "email": "foo@bar.com"}`), &u))
+	assert.Equal(t, "foo", u.Name)
+}
+
+func TestDecode_Unregistered(t *testing.T) {
+	var a address
+	assert.NoError(t, jsonz.Decode(strings.NewReader(`{"zip": ""}`), &a))
+}
@@ -0,0 +1,16 @@
+package jsonz
+
+import "github.com/imulab/check/catalog"
+
+// Messages contains the English message templates of the decode error codes. They are registered into catalog on
+// init, so decode errors render like validation failures.
+var Messages = map[string]string{
+	CodeEmpty:        "must not be empty",
+	CodeSyntax:       "must be well-formed JSON",
+	CodeType:         "must be of type {expected}, got {actual}",
+	CodeUnknownField: "is not a known field",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
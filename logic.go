@@ -0,0 +1,125 @@
+package check
+
+import "errors"
+
+var (
+	// ErrOr is matched (via errors.Is) by the OrError returned by Or when none of the alternatives passed.
+	ErrOr = errors.New("none of the alternatives passed")
+	// ErrNot is returned (wrapped in Error) by Not when the negated Step passed.
+	ErrNot = errors.New("step passed unexpectedly")
+	// ErrXor is returned (wrapped in Error) by Xor when not exactly one of the alternatives passed.
+	ErrXor = errors.New("not exactly one of the alternatives passed")
+)
+
+// Codes of the errors returned by the combinators. CodeOr is the code of OrError, while the others are codes of
+// the Error returned.
+const (
+	CodeOr  = "check.or"
+	CodeNot = "check.not"
+	CodeXor = "check.xor"
+)
+
+// OrError is the error returned by Or when none of the alternatives passed. It lists why each alternative failed,
+// in the order of the alternatives. It matches ErrOr, as well as any of the listed errors, with errors.Is.
+type OrError struct {
+	Errors Errors
+}
+
+// Error reports the errors of all alternatives.
+func (e *OrError) Error() string {
+	return ErrOr.Error() + ": " + e.Errors.Error()
+}
+
+// Is reports whether target is ErrOr.
+func (e *OrError) Is(target error) bool {
+	return target == ErrOr
+}
+
+// Unwrap returns the errors of all alternatives.
+func (e *OrError) Unwrap() error {
+	return e.Errors
+}
+
+// The combinators below treat a Step as a predicate, which passes when it returns nil or Skip. Skip returned by
+// a branch is always contained within the combinator, and never skips the Step that follows the combinator.
+
+// And returns a Step that passes when all steps pass. Steps are executed sequentially, and the first error is
+// returned. Like That, a Step returning Skip skips the remaining steps, and And passes.
+func And(steps ...Step) Step {
+	return func(target interface{}) error {
+		for _, s := range steps {
+			if err := s(target); err != nil {
+				switch err {
+				case Skip:
+					return nil
+				default:
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// Or returns a Step that passes when any of the alternatives passes (returns nil or Skip). Alternatives are executed
+// sequentially until one passes. If none passed, an OrError listing the error of each alternative is returned. As
+// with Not, an error matching ErrUnexpectedType is returned as is, instead of being counted as a failed alternative.
+//
+//	// This example accepts either an empty string, or a UUID.
+//	check.That(str, check.Or(stringz.IsEmpty, stringz.Matches(uuidPattern)))
+func Or(alternatives ...Step) Step {
+	return func(target interface{}) error {
+		errs := make(Errors, 0, len(alternatives))
+		for _, s := range alternatives {
+			err := s(target)
+			switch {
+			case err == nil, err == Skip:
+				return nil
+			case errors.Is(err, ErrUnexpectedType):
+				return err
+			}
+			errs = append(errs, err)
+		}
+		return &OrError{Errors: errs}
+	}
+}
+
+// Not returns a Step that passes when the given Step fails, or returns ErrNot when it passes (returns nil or Skip).
+// As an exception, an error matching ErrUnexpectedType is returned as is, instead of being negated.
+//
+//	// This example rejects reserved words.
+//	check.That(str, check.Not(stringz.In("admin", "root")))
+func Not(step Step) Step {
+	return func(target interface{}) error {
+		err := step(target)
+		switch {
+		case err == nil, err == Skip:
+			return NewError(ErrNot, CodeNot, target, nil)
+		case errors.Is(err, ErrUnexpectedType):
+			return err
+		default:
+			return nil
+		}
+	}
+}
+
+// Xor returns a Step that passes when exactly one of the alternatives passes (returns nil or Skip), or returns
+// ErrXor. All alternatives are executed, unless one returns an error matching ErrUnexpectedType, which is returned
+// as is.
+func Xor(alternatives ...Step) Step {
+	return func(target interface{}) error {
+		passed := 0
+		for _, s := range alternatives {
+			switch err := s(target); {
+			case err == nil, err == Skip:
+				passed++
+			case errors.Is(err, ErrUnexpectedType):
+				return err
+			}
+		}
+		if passed == 1 {
+			return nil
+		}
+		return NewError(ErrXor, CodeXor, target, Params{"passed": passed})
+	}
+}
@@ -0,0 +1,96 @@
+package check_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	skipStep check.Step = func(target interface{}) error {
+		return check.Skip
+	}
+	errOne       = errors.New("one")
+	errTwo       = errors.New("two")
+	wrongStepOne = wrongStep.Err(errOne)
+	wrongStepTwo = wrongStep.Err(errTwo)
+)
+
+func TestAnd(t *testing.T) {
+	cases := []struct {
+		name  string
+		steps []check.Step
+		err   error
+	}{
+		{name: "all pass", steps: []check.Step{correctStep, correctStep}},
+		{name: "first error", steps: []check.Step{correctStep, wrongStepOne, wrongStepTwo}, err: errOne},
+		{name: "skip passes", steps: []check.Step{skipStep, wrongStepOne}},
+		{name: "no steps", steps: nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.err, check.And(c.steps...)("foo"))
+		})
+	}
+}
+
+func TestOr(t *testing.T) {
+	cases := []struct {
+		name  string
+		steps []check.Step
+		err   error
+	}{
+		{name: "one passes", steps: []check.Step{wrongStepOne, correctStep}},
+		{name: "skip passes", steps: []check.Step{wrongStepOne, skipStep}},
+		{name: "none passes", steps: []check.Step{wrongStepOne, wrongStepTwo}, err: &check.OrError{Errors: check.Errors{errOne, errTwo}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.err, check.Or(c.steps...)("foo"))
+		})
+	}
+
+	err := check.Or(wrongStepOne, wrongStepTwo)("foo")
+	assert.True(t, errors.Is(err, check.ErrOr))
+	assert.True(t, errors.Is(err, errTwo))
+
+	typeErr := &check.TypeError{Expected: "string", Actual: "int"}
+	assert.Equal(t, typeErr, check.Or(wrongStepOne, wrongStep.Err(typeErr), correctStep)("foo"))
+}
+
+func TestNot(t *testing.T) {
+	assert.NoError(t, check.Not(wrongStep)("foo"))
+	assert.Equal(t, check.NewError(check.ErrNot, check.CodeNot, "foo", nil), check.Not(correctStep)("foo"))
+	assert.True(t, errors.Is(check.Not(skipStep)("foo"), check.ErrNot))
+
+	typeErr := &check.TypeError{Expected: "string", Actual: "int"}
+	assert.Equal(t, typeErr, check.Not(wrongStep.Err(typeErr))("foo"))
+}
+
+func TestXor(t *testing.T) {
+	cases := []struct {
+		name  string
+		steps []check.Step
+		err   error
+	}{
+		{name: "exactly one", steps: []check.Step{wrongStep, correctStep, wrongStep}},
+		{name: "exactly one skip", steps: []check.Step{wrongStep, skipStep}},
+		{name: "none", steps: []check.Step{wrongStep, wrongStep}, err: check.ErrXor},
+		{name: "more than one", steps: []check.Step{correctStep, skipStep}, err: check.ErrXor},
+		{name: "type error", steps: []check.Step{correctStep, wrongStep.Err(&check.TypeError{})}, err: check.ErrUnexpectedType},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.Xor(c.steps...)("foo")
+			if c.err != nil {
+				assert.True(t, errors.Is(err, c.err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Package mapz contains check.Step implementation related to maps.
+//
+// The untyped check.Step accept either map[string]string, such as labels, or map[string]interface{}, such as a
+// decoded JSON object. Type-safe check.StepOf for maps of any key and value type are available via the generic
+// namespace Of.
+//
+//	check.That(labels,
+//		mapz.Required("region"),
+//		mapz.Key("region", stringz.In("us", "eu")),
+//		mapz.Keys(stringz.HasLengthInRange(1, 64)),
+//	)
+//	check.ThatOf(quotas, mapz.Of[map[string]int64]().Values(int64z.Of[int64]().Positive))
+//
+// Errors concerning a particular entry are annotated with the key as their path (see check.WithPath), so a failure
+// of the "region" key in a "labels" field is reported at "labels.region". Keys containing ".", brackets or quotes, and
+// the empty key, are quoted in brackets instead, i.e. labels["app.kubernetes.io/name"]. Checks run over the entries
+// in the order of the formatted keys, so the same map always yields the same error.
+package mapz
@@ -0,0 +1,23 @@
+package mapz
+
+import "errors"
+
+var (
+	ErrIsEmpty          = errors.New("map is empty")
+	ErrIsNotEmpty       = errors.New("map is not empty")
+	ErrHasLength        = errors.New("map does not have expected number of keys")
+	ErrHasLengthInRange = errors.New("map does not have number of keys in expected range")
+	ErrRequired         = errors.New("map does not have required key")
+	ErrForbidden        = errors.New("map has forbidden key")
+)
+
+// Codes of the check.Error returned by the check.Step in this package.
+const (
+	CodeIsEmpty          = "map.is_empty"
+	CodeIsNotEmpty       = "map.is_not_empty"
+	CodeHasLength        = "map.has_length"
+	CodeHasLengthInRange = "map.has_length_in_range"
+	CodeRequired         = "map.required"
+	CodeForbidden        = "map.forbidden"
+	CodeHasLengthWithin  = "map.has_length_within"
+)
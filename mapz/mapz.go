@@ -0,0 +1,88 @@
+package mapz
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+)
+
+var (
+	anyMaps    = Of[map[string]interface{}]()
+	stringMaps = Of[map[string]string]()
+)
+
+// IsEmpty is a check.Step that verifies the target map has no keys, or returns ErrIsNotEmpty.
+var IsEmpty = untyped(anyMaps.IsEmpty, stringMaps.IsEmpty)
+
+// IsNotEmpty is a check.Step that verifies the target map has some keys, or returns ErrIsEmpty.
+var IsNotEmpty = untyped(anyMaps.IsNotEmpty, stringMaps.IsNotEmpty)
+
+// HasLength returns check.Step that verifies the target map has the expected number of keys, or returns
+// ErrHasLength.
+func HasLength(length int) check.Step {
+	return untyped(anyMaps.HasLength(length), stringMaps.HasLength(length))
+}
+
+// HasLengthInRange returns check.Step that verifies the number of keys in the target map is in the expected range,
+// or returns ErrHasLengthInRange.
+func HasLengthInRange(startInclusive int, endExclusive int) check.Step {
+	return untyped(
+		anyMaps.HasLengthInRange(startInclusive, endExclusive),
+		stringMaps.HasLengthInRange(startInclusive, endExclusive),
+	)
+}
+
+// HasLengthWithin returns check.Step that verifies the number of keys in the target map is in the rangez.Range, or
+// returns ErrHasLengthInRange.
+func HasLengthWithin(r rangez.Range[int]) check.Step {
+	return untyped(anyMaps.HasLengthWithin(r), stringMaps.HasLengthWithin(r))
+}
+
+// Required returns check.Step that verifies the target map has all the keys, or returns check.Errors reporting
+// ErrRequired for each missing key.
+func Required(keys ...string) check.Step {
+	return untyped(anyMaps.Required(keys...), stringMaps.Required(keys...))
+}
+
+// Forbidden returns check.Step that verifies the target map has none of the keys, or returns check.Errors reporting
+// ErrForbidden for each present key.
+func Forbidden(keys ...string) check.Step {
+	return untyped(anyMaps.Forbidden(keys...), stringMaps.Forbidden(keys...))
+}
+
+// Key returns check.Step that runs the steps against the value of the key, and annotates the error with the key.
+// A missing key passes.
+//
+//	mapz.Key("region", stringz.In("us", "eu"))
+func Key(key string, steps ...check.Step) check.Step {
+	return untyped(
+		anyMaps.Key(key, func(target interface{}) error { return check.That(target, steps...)() }),
+		stringMaps.Key(key, func(target string) error { return check.That(target, steps...)() }),
+	)
+}
+
+// Keys checks all keys of the target map conform to the condition of the key check.Step, or returns the first
+// error annotated with the key.
+func Keys(keyStep check.Step) check.Step {
+	return untyped(anyMaps.Keys(check.Typed[string](keyStep)), stringMaps.Keys(check.Typed[string](keyStep)))
+}
+
+// Values checks all values of the target map conform to the condition of the value check.Step, or returns the
+// first error annotated with the key.
+func Values(valueStep check.Step) check.Step {
+	return untyped(anyMaps.Values(check.Typed[interface{}](valueStep)), stringMaps.Values(check.Typed[string](valueStep)))
+}
+
+// untyped adapts the check.StepOf pair into check.Step, dispatching on whether the target is map[string]interface{}
+// or map[string]string. The target is handed over as is, so errors report the caller's map.
+func untyped(anyStep check.StepOf[map[string]interface{}], stringStep check.StepOf[map[string]string]) check.Step {
+	return func(target interface{}) error {
+		switch m := target.(type) {
+		case map[string]interface{}:
+			return anyStep(m)
+		case map[string]string:
+			return stringStep(m)
+		default:
+			return check.NewTypeError[map[string]interface{}](target)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package mapz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/mapz"
+	"github.com/imulab/check/rangez"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func TestUntyped(t *testing.T) {
+	labels := map[string]string{"region": "us", "team": "core"}
+	object := map[string]interface{}{"region": "ap", "size": int64(3)}
+
+	cases := []struct {
+		name   string
+		target interface{}
+		step   check.Step
+		err    error
+		paths  []string
+	}{
+		{name: "is empty", target: map[string]string{}, step: mapz.IsEmpty},
+		{name: "not empty", target: labels, step: mapz.IsEmpty, err: mapz.ErrIsNotEmpty},
+		{name: "is not empty", target: object, step: mapz.IsNotEmpty},
+		{name: "has length", target: labels, step: mapz.HasLength(2)},
+		{name: "not has length", target: object, step: mapz.HasLength(3), err: mapz.ErrHasLength},
+		{name: "has length in range", target: labels, step: mapz.HasLengthInRange(1, 3)},
+		{name: "not has length in range", target: labels, step: mapz.HasLengthInRange(3, 5), err: mapz.ErrHasLengthInRange},
+		{name: "has length within", target: labels, step: mapz.HasLengthWithin(rangez.AtLeast(2))},
+		{name: "not has length within", target: labels, step: mapz.HasLengthWithin(rangez.LessThan(2)), err: mapz.ErrHasLengthInRange},
+		{name: "required", target: labels, step: mapz.Required("region", "team")},
+		{
+			name:   "not required",
+			target: object,
+			step:   mapz.Required("team", "region", "owner"),
+			err:    mapz.ErrRequired,
+			paths:  []string{"team", "owner"},
+		},
+		{name: "forbidden", target: labels, step: mapz.Forbidden("size")},
+		{name: "not forbidden", target: object, step: mapz.Forbidden("size"), err: mapz.ErrForbidden, paths: []string{"size"}},
+		{name: "key", target: labels, step: mapz.Key("region", stringz.In("us", "eu"))},
+		{name: "missing key", target: labels, step: mapz.Key("size", stringz.In("us", "eu"))},
+		{name: "invalid key", target: object, step: mapz.Key("region", stringz.In("us", "eu")), err: stringz.ErrIn, paths: []string{"region"}},
+		{name: "keys", target: labels, step: mapz.Keys(stringz.Matches(regexp.MustCompile(`^[a-z]+$`)))},
+		{name: "invalid keys", target: object, step: mapz.Keys(stringz.HasLength(4)), err: stringz.ErrHasLength, paths: []string{"region"}},
+		{name: "values", target: labels, step: mapz.Values(stringz.IsNotEmpty)},
+		{name: "invalid values", target: object, step: mapz.Values(stringz.IsNotEmpty), err: check.ErrUnexpectedType, paths: []string{"size"}},
+		{name: "unexpected type", target: map[string]int{}, step: mapz.IsEmpty, err: check.ErrUnexpectedType},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, c.err))
+			assert.Equal(t, c.paths, pathsOf(err))
+		})
+	}
+}
+
+func TestUntyped_Value(t *testing.T) {
+	labels := map[string]string{"region": "us"}
+
+	var checkErr *check.Error
+	if assert.True(t, errors.As(check.That(labels, mapz.HasLength(2))(), &checkErr)) {
+		assert.Equal(t, labels, checkErr.Value)
+	}
+}
+
+func TestTyped(t *testing.T) {
+	quotas := map[int]int64{443: 10, 80: 0, 8080: -1}
+	positive := func(target int64) error {
+		if target > 0 {
+			return nil
+		}
+		return errors.New("not positive")
+	}
+
+	err := check.ThatOf(quotas, mapz.Of[map[int]int64]().Values(positive))()
+	assert.Equal(t, []string{"80"}, pathsOf(err))
+
+	err = check.ThatOf(quotas, mapz.Of[map[int]int64]().Key(8080, positive))()
+	assert.Equal(t, []string{"8080"}, pathsOf(err))
+
+	err = check.ThatOf(quotas, mapz.Of[map[int]int64]().Required(443, 22))()
+	assert.Equal(t, []string{"22"}, pathsOf(err))
+}
+
+func TestKey_Path(t *testing.T) {
+	err := check.That(map[string]string{"region": "ap"}, mapz.Key("region", stringz.In("us"))).Path("labels")()
+
+	var pathErr *check.PathError
+	if assert.True(t, errors.As(err, &pathErr)) {
+		assert.Equal(t, "labels.region", pathErr.Path)
+	}
+}
+
+func TestKey_QuotedPath(t *testing.T) {
+	labels := map[string]string{"app.kubernetes.io/name": "", "": "x", "plain": ""}
+
+	err := check.That(labels, mapz.Values(stringz.IsNotEmpty)).Path("labels")()
+	assert.Equal(t, []string{`labels["app.kubernetes.io/name"]`}, pathsOf(err))
+
+	err = check.That(labels, mapz.Keys(stringz.IsNotEmpty)).Path("labels")()
+	assert.Equal(t, []string{`labels[""]`}, pathsOf(err))
+
+	err = check.That(labels, mapz.Forbidden("plain", "a.b")).Path("labels")()
+	assert.Equal(t, []string{"labels.plain"}, pathsOf(err))
+}
+
+func pathsOf(err error) []string {
+	var errs check.Errors
+	if !errors.As(err, &errs) {
+		errs = check.Errors{err}
+	}
+
+	var paths []string
+	for _, it := range errs {
+		var pathErr *check.PathError
+		if errors.As(it, &pathErr) {
+			paths = append(paths, pathErr.Path)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,18 @@
+package mapz
+
+import "github.com/imulab/check/catalog"
+
+// Messages contains the English message templates of the map codes, registered into catalog on init.
+var Messages = map[string]string{
+	CodeIsEmpty:          "must be empty",
+	CodeIsNotEmpty:       "must not be empty",
+	CodeHasLength:        "must have {length} keys",
+	CodeHasLengthInRange: "must have at least {start} and less than {end} keys",
+	CodeHasLengthWithin:  "must have a number of keys within {range}",
+	CodeRequired:         "is required",
+	CodeForbidden:        "is not allowed",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
@@ -0,0 +1,179 @@
+package mapz
+
+import (
+	"fmt"
+	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Of returns the namespace for all check.StepOf which assumes the target is of map type M, with keys of type K and
+// values of type V. K and V are inferred from M.
+//
+//	check.ThatOf(labels, mapz.Of[map[string]string]().Key("region", stringz.Of[string]().In("us", "eu")))
+func Of[M ~map[K]V, K comparable, V any]() Typed[M, K, V] {
+	return Typed[M, K, V]{
+		IsEmpty: func(target M) error {
+			if len(target) == 0 {
+				return nil
+			}
+			return check.NewError(ErrIsNotEmpty, CodeIsEmpty, target, nil)
+		},
+		IsNotEmpty: func(target M) error {
+			if len(target) > 0 {
+				return nil
+			}
+			return check.NewError(ErrIsEmpty, CodeIsNotEmpty, target, nil)
+		},
+	}
+}
+
+// Typed is the namespace for check.StepOf with respect to map type M. Use Of to obtain an instance.
+type Typed[M ~map[K]V, K comparable, V any] struct {
+	// IsEmpty is a check.StepOf that verifies the target map
+	// has no keys, or returns ErrIsNotEmpty.
+	IsEmpty check.StepOf[M]
+	// IsNotEmpty is a check.StepOf that verifies the target map
+	// has some keys, or returns ErrIsEmpty.
+	IsNotEmpty check.StepOf[M]
+}
+
+// HasLength returns check.StepOf that verifies the target map has the expected number of keys, or returns
+// ErrHasLength.
+func (Typed[M, K, V]) HasLength(length int) check.StepOf[M] {
+	return func(target M) error {
+		if len(target) == length {
+			return nil
+		}
+		return check.NewError(ErrHasLength, CodeHasLength, target, check.Params{"length": length})
+	}
+}
+
+// HasLengthInRange returns check.StepOf that verifies the number of keys in the target map is at least
+// startInclusive and less than endExclusive, or returns ErrHasLengthInRange.
+func (Typed[M, K, V]) HasLengthInRange(startInclusive int, endExclusive int) check.StepOf[M] {
+	return func(target M) error {
+		length := len(target)
+		if startInclusive <= length && length < endExclusive {
+			return nil
+		}
+		return check.NewError(ErrHasLengthInRange, CodeHasLengthInRange, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// HasLengthWithin returns check.StepOf that verifies the number of keys in the target map is in the rangez.Range,
+// or returns ErrHasLengthInRange with the "range" parameter.
+func (Typed[M, K, V]) HasLengthWithin(r rangez.Range[int]) check.StepOf[M] {
+	return func(target M) error {
+		if r.Contains(len(target)) {
+			return nil
+		}
+		return check.NewError(ErrHasLengthInRange, CodeHasLengthWithin, target, check.Params{"range": r})
+	}
+}
+
+// Required returns check.StepOf that verifies the target map has all the keys. Each missing key is reported as
+// ErrRequired annotated with the key, and all of them are returned together as check.Errors.
+func (Typed[M, K, V]) Required(keys ...K) check.StepOf[M] {
+	return func(target M) error {
+		var errs check.Errors
+		for _, k := range keys {
+			if _, ok := target[k]; !ok {
+				errs = append(errs, check.WithPath(pathOf(k),
+					check.NewError(ErrRequired, CodeRequired, target, check.Params{"key": k})))
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errs
+	}
+}
+
+// Forbidden is the opposite of Required. Each present key is reported as ErrForbidden annotated with the key, and
+// all of them are returned together as check.Errors.
+func (Typed[M, K, V]) Forbidden(keys ...K) check.StepOf[M] {
+	return func(target M) error {
+		var errs check.Errors
+		for _, k := range keys {
+			if _, ok := target[k]; ok {
+				errs = append(errs, check.WithPath(pathOf(k),
+					check.NewError(ErrForbidden, CodeForbidden, target, check.Params{"key": k})))
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errs
+	}
+}
+
+// Key returns check.StepOf that runs the steps against the value of the key, like check.ThatOf, and annotates the
+// error with the key. A missing key passes, so combine it with Required if the key is mandatory.
+//
+//	mapz.Of[map[string]string]().Key("region", stringz.Of[string]().In("us", "eu"))
+func (Typed[M, K, V]) Key(key K, steps ...check.StepOf[V]) check.StepOf[M] {
+	return func(target M) error {
+		v, ok := target[key]
+		if !ok {
+			return nil
+		}
+		return check.WithPath(pathOf(key), check.ThatOf(v, steps...)())
+	}
+}
+
+// Keys checks all keys of the target map conform to the condition of the key check.StepOf. The first error is
+// returned, annotated with the offending key.
+func (Typed[M, K, V]) Keys(keyStep check.StepOf[K]) check.StepOf[M] {
+	return func(target M) error {
+		for _, k := range sortedKeys(target) {
+			if err := keyStep(k); err != nil {
+				return check.WithPath(pathOf(k), err)
+			}
+		}
+		return nil
+	}
+}
+
+// Values checks all values of the target map conform to the condition of the value check.StepOf. The first error
+// is returned, annotated with the key of the offending value. Like slicez.Typed.All, the value check.StepOf is NOT
+// recommended to use check.Skip.
+func (Typed[M, K, V]) Values(valueStep check.StepOf[V]) check.StepOf[M] {
+	return func(target M) error {
+		for _, k := range sortedKeys(target) {
+			if err := valueStep(target[k]); err != nil {
+				return check.WithPath(pathOf(k), err)
+			}
+		}
+		return nil
+	}
+}
+
+// pathOf returns the path segment of the key. Keys which would read as more than one segment, i.e. "a.b", or as
+// none are quoted in brackets, i.e. ["a.b"], so that the path stays unambiguous.
+func pathOf(key interface{}) string {
+	p := fmt.Sprint(key)
+	if len(p) == 0 || strings.ContainsAny(p, ".[]\"") {
+		return "[" + strconv.Quote(p) + "]"
+	}
+	return p
+}
+
+// sortedKeys returns the keys of the map in the order of their formatted value.
+func sortedKeys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	paths := make(map[K]string, len(m))
+	for k := range m {
+		keys = append(keys, k)
+		paths[k] = fmt.Sprint(k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return paths[keys[i]] < paths[keys[j]]
+	})
+	return keys
+}
@@ -0,0 +1,103 @@
+package numz
+
+import (
+	"fmt"
+	"github.com/imulab/check"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var decimalPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// DecimalOf returns the namespace for all check.StepOf which assumes the target is a decimal number in string
+// type S, i.e. "-12.50". Exponents are not accepted. Checks in this namespace are exact, so they are suitable for
+// amounts of money.
+//
+//	check.ThatOf(amount, numz.DecimalOf[string]().MaxDecimalPlaces(2), numz.DecimalOf[string]().MultipleOf("0.05"))
+func DecimalOf[S ~string]() DecimalTyped[S] {
+	return DecimalTyped[S]{
+		IsDecimal: func(target S) error {
+			if decimalPattern.MatchString(string(target)) {
+				return nil
+			}
+			return check.NewError(ErrDecimal, CodeDecimal, target, nil)
+		},
+	}
+}
+
+// DecimalTyped is the namespace for check.StepOf with respect to decimal numbers in string type S. Use DecimalOf to
+// obtain an instance. Every check.StepOf in this namespace returns ErrDecimal if the target is not a decimal number.
+type DecimalTyped[S ~string] struct {
+	// IsDecimal is a check.StepOf that verifies the target
+	// is a decimal number, or returns ErrDecimal.
+	IsDecimal check.StepOf[S]
+}
+
+// MaxDecimalPlaces returns a check.StepOf that checks the target has at most the number of digits after the
+// decimal point, or returns ErrDecimalPlaces with the "places" parameter. Trailing zeros are not counted, so
+// "1.50" has one decimal place.
+func (d DecimalTyped[S]) MaxDecimalPlaces(places int) check.StepOf[S] {
+	return func(target S) error {
+		if err := d.IsDecimal(target); err != nil {
+			return err
+		}
+		if _, fraction := digitsOf(string(target)); len(fraction) <= places {
+			return nil
+		}
+		return check.NewError(ErrDecimalPlaces, CodeDecimalPlaces, target, check.Params{"places": places})
+	}
+}
+
+// MaxPrecision returns a check.StepOf that checks the target has at most the number of significant digits, or
+// returns ErrPrecision with the "precision" parameter. Leading zeros and trailing zeros after the decimal point are
+// not significant, so "0.050" has a precision of 1, and "1200" has a precision of 4.
+func (d DecimalTyped[S]) MaxPrecision(precision int) check.StepOf[S] {
+	return func(target S) error {
+		if err := d.IsDecimal(target); err != nil {
+			return err
+		}
+		if precisionOf(string(target)) <= precision {
+			return nil
+		}
+		return check.NewError(ErrPrecision, CodePrecision, target, check.Params{"precision": precision})
+	}
+}
+
+// MultipleOf returns a check.StepOf that checks the target is an exact multiple of the unit, or returns
+// ErrMultipleOf with the "divisor" parameter. It panics if the unit is not a positive decimal number.
+//
+//	numz.DecimalOf[string]().MultipleOf("0.05")	// accepts "1.25", rejects "1.26"
+func (d DecimalTyped[S]) MultipleOf(unit string) check.StepOf[S] {
+	divisor, ok := new(big.Rat).SetString(unit)
+	if !ok || !decimalPattern.MatchString(unit) || divisor.Sign() <= 0 {
+		panic(fmt.Sprintf("numz: %q is not a positive decimal number", unit))
+	}
+
+	return func(target S) error {
+		if err := d.IsDecimal(target); err != nil {
+			return err
+		}
+		value, _ := new(big.Rat).SetString(string(target))
+		if value.Quo(value, divisor).IsInt() {
+			return nil
+		}
+		return check.NewError(ErrMultipleOf, CodeMultipleOf, target, check.Params{"divisor": unit})
+	}
+}
+
+// digitsOf splits the decimal number into its integer digits without leading zeros and its fractional digits
+// without trailing zeros.
+func digitsOf(decimal string) (integer string, fraction string) {
+	decimal = strings.TrimLeft(decimal, "+-")
+	integer, fraction, _ = strings.Cut(decimal, ".")
+	return strings.TrimLeft(integer, "0"), strings.TrimRight(fraction, "0")
+}
+
+func precisionOf(decimal string) int {
+	integer, fraction := digitsOf(decimal)
+	if len(integer) > 0 {
+		return len(integer) + len(fraction)
+	}
+	return len(strings.TrimLeft(fraction, "0"))
+}
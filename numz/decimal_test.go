@@ -0,0 +1,92 @@
+package numz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/numz"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type amount string
+
+func TestDecimalOf(t *testing.T) {
+	d := numz.DecimalOf[amount]()
+
+	cases := []struct {
+		name   string
+		target amount
+		step   check.StepOf[amount]
+		err    error
+	}{
+		{name: "decimal", target: "-12.50", step: d.IsDecimal},
+		{name: "integer", target: "+12", step: d.IsDecimal},
+		{name: "exponent", target: "1e3", step: d.IsDecimal, err: numz.ErrDecimal},
+		{name: "missing fraction", target: "1.", step: d.IsDecimal, err: numz.ErrDecimal},
+		{name: "empty", target: "", step: d.IsDecimal, err: numz.ErrDecimal},
+		{name: "decimal places", target: "12.34", step: d.MaxDecimalPlaces(2)},
+		{name: "trailing zeros", target: "12.3400", step: d.MaxDecimalPlaces(2)},
+		{name: "too many decimal places", target: "12.345", step: d.MaxDecimalPlaces(2), err: numz.ErrDecimalPlaces},
+		{name: "not decimal places", target: "abc", step: d.MaxDecimalPlaces(2), err: numz.ErrDecimal},
+		{name: "precision", target: "0.050", step: d.MaxPrecision(1)},
+		{name: "integer precision", target: "1200", step: d.MaxPrecision(4)},
+		{name: "too much precision", target: "-1.25", step: d.MaxPrecision(2), err: numz.ErrPrecision},
+		{name: "zero precision", target: "0.000", step: d.MaxPrecision(0)},
+		{name: "multiple of", target: "1.25", step: d.MultipleOf("0.05")},
+		{name: "negative multiple of", target: "-100", step: d.MultipleOf("0.05")},
+		{name: "not multiple of", target: "1.26", step: d.MultipleOf("0.05"), err: numz.ErrMultipleOf},
+		{name: "not decimal multiple of", target: "1/2", step: d.MultipleOf("0.5"), err: numz.ErrDecimal},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestDecimalOf_InvalidUnit(t *testing.T) {
+	for _, unit := range []string{"0", "-0.05", "1/20", "abc"} {
+		assert.Panics(t, func() { numz.DecimalOf[string]().MultipleOf(unit) }, unit)
+	}
+}
+
+func TestFloatOf_Digits(t *testing.T) {
+	f64 := numz.FloatOf[float64]()
+	tenth := 0.1
+
+	cases := []struct {
+		name   string
+		target float64
+		step   check.StepOf[float64]
+		err    error
+	}{
+		{name: "rounding error", target: tenth + 0.2, step: f64.MaxDecimalPlaces(2), err: numz.ErrDecimalPlaces},
+		{name: "shortest representation", target: 1.1, step: f64.MaxDecimalPlaces(1)},
+		{name: "too many decimal places", target: 1.005, step: f64.MaxDecimalPlaces(2), err: numz.ErrDecimalPlaces},
+		{name: "integer decimal places", target: 100, step: f64.MaxDecimalPlaces(0)},
+		{name: "nan decimal places", target: math.NaN(), step: f64.MaxDecimalPlaces(2), err: numz.ErrDecimalPlaces},
+		{name: "precision", target: 123.45, step: f64.MaxPrecision(5)},
+		{name: "too much precision", target: 123.45, step: f64.MaxPrecision(4), err: numz.ErrPrecision},
+		{name: "inf precision", target: math.Inf(1), step: f64.MaxPrecision(4), err: numz.ErrPrecision},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+
+	assert.NoError(t, check.ThatOf(float32(0.1), numz.FloatOf[float32]().MaxDecimalPlaces(1))())
+}
@@ -0,0 +1,16 @@
+// Package numz contains check.StepOf implementation for all built-in integer and floating point types, including
+// named types. It is the generic counterpart of int64z, which remains for untyped check.Step on int64.
+//
+//	check.ThatOf(pageSize, numz.Of[int]().InRange(1, 101))
+//	check.ThatOf(port, numz.Of[uint16]().Positive)
+//	check.ThatOf(price, numz.FloatOf[float64]().Finite, numz.FloatOf[float64]().Positive)
+//
+// IntegerOf adds divisibility checks, and DecimalOf validates decimal numbers kept in strings exactly, without going
+// through a float.
+//
+//	check.ThatOf(quantity, numz.IntegerOf[int]().StepFrom(10, 10))
+//	check.ThatOf(amount, numz.DecimalOf[string]().MaxDecimalPlaces(2))
+//
+// Use StepOf.Step to obtain an untyped check.Step, i.e. for check.That. Failures carry the bounds of the rule in
+// the Params of *check.Error.
+package numz
@@ -0,0 +1,86 @@
+package numz
+
+import (
+	"github.com/imulab/check"
+	"math"
+	"strconv"
+	"unsafe"
+)
+
+// FloatOf returns the namespace for all check.StepOf which assumes the target is of floating point type F. Besides
+// everything in Of, it checks for NaN and infinity. Note that NaN fails all comparisons of Of, except NotEqual.
+//
+//	check.ThatOf(ratio, numz.FloatOf[float64]().Finite)
+func FloatOf[F Float]() FloatTyped[F] {
+	return FloatTyped[F]{
+		Typed: Of[F](),
+		NotNaN: func(target F) error {
+			if !math.IsNaN(float64(target)) {
+				return nil
+			}
+			return check.NewError(ErrNaN, CodeNotNaN, target, nil)
+		},
+		NotInf: func(target F) error {
+			if !math.IsInf(float64(target), 0) {
+				return nil
+			}
+			return check.NewError(ErrInf, CodeNotInf, target, nil)
+		},
+		Finite: func(target F) error {
+			if f := float64(target); !math.IsNaN(f) && !math.IsInf(f, 0) {
+				return nil
+			}
+			return check.NewError(ErrFinite, CodeFinite, target, nil)
+		},
+	}
+}
+
+// FloatTyped is the namespace for check.StepOf with respect to floating point type F. Use FloatOf to obtain an
+// instance.
+type FloatTyped[F Float] struct {
+	Typed[F]
+	// NotNaN is a check.StepOf that verifies the target
+	// is not NaN, or returns ErrNaN.
+	NotNaN check.StepOf[F]
+	// NotInf is a check.StepOf that verifies the target
+	// is neither +Inf nor -Inf, or returns ErrInf.
+	NotInf check.StepOf[F]
+	// Finite is a check.StepOf that verifies the target
+	// is neither NaN nor infinite, or returns ErrFinite.
+	Finite check.StepOf[F]
+}
+
+// MaxDecimalPlaces returns a check.StepOf that checks the target has at most the number of digits after the
+// decimal point, or returns ErrDecimalPlaces with the "places" parameter. The digits are those of the shortest
+// decimal representation of the target, so 0.1 has one decimal place despite the binary approximation. NaN and
+// infinity always fail.
+func (FloatTyped[F]) MaxDecimalPlaces(places int) check.StepOf[F] {
+	return func(target F) error {
+		if decimal, ok := decimalOf(target); ok {
+			if _, fraction := digitsOf(decimal); len(fraction) <= places {
+				return nil
+			}
+		}
+		return check.NewError(ErrDecimalPlaces, CodeDecimalPlaces, target, check.Params{"places": places})
+	}
+}
+
+// MaxPrecision returns a check.StepOf that checks the target has at most the number of significant digits, or
+// returns ErrPrecision with the "precision" parameter. Like MaxDecimalPlaces, the shortest decimal representation
+// of the target is considered. NaN and infinity always fail.
+func (FloatTyped[F]) MaxPrecision(precision int) check.StepOf[F] {
+	return func(target F) error {
+		if decimal, ok := decimalOf(target); ok && precisionOf(decimal) <= precision {
+			return nil
+		}
+		return check.NewError(ErrPrecision, CodePrecision, target, check.Params{"precision": precision})
+	}
+}
+
+// decimalOf formats the finite float in the shortest decimal representation that parses back to the same value.
+func decimalOf[F Float](f F) (string, bool) {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return "", false
+	}
+	return strconv.FormatFloat(float64(f), 'f', -1, int(unsafe.Sizeof(f))*8), true
+}
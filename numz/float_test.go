@@ -0,0 +1,49 @@
+package numz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/numz"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestFloatOf(t *testing.T) {
+	f64 := numz.FloatOf[float64]()
+
+	cases := []struct {
+		name   string
+		target float64
+		step   check.StepOf[float64]
+		err    error
+	}{
+		{name: "not nan", target: 1, step: f64.NotNaN},
+		{name: "nan", target: math.NaN(), step: f64.NotNaN, err: numz.ErrNaN},
+		{name: "not inf", target: math.MaxFloat64, step: f64.NotInf},
+		{name: "positive inf", target: math.Inf(1), step: f64.NotInf, err: numz.ErrInf},
+		{name: "negative inf", target: math.Inf(-1), step: f64.NotInf, err: numz.ErrInf},
+		{name: "finite", target: -1.5, step: f64.Finite},
+		{name: "nan not finite", target: math.NaN(), step: f64.Finite, err: numz.ErrFinite},
+		{name: "inf not finite", target: math.Inf(1), step: f64.Finite, err: numz.ErrFinite},
+		{name: "nan not in range", target: math.NaN(), step: f64.InRange(0, 1), err: numz.ErrInRange},
+		{name: "nan not positive", target: math.NaN(), step: f64.Positive, err: numz.ErrGreaterThan},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestFloatOf_Float32(t *testing.T) {
+	f32 := numz.FloatOf[float32]()
+	assert.NoError(t, check.ThatOf(float32(0.5), f32.Finite, f32.InRange(0, 1))())
+	assert.True(t, errors.Is(check.ThatOf(float32(math.Inf(-1)), f32.Finite)(), numz.ErrFinite))
+}
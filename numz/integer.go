@@ -0,0 +1,44 @@
+package numz
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/internal/arith"
+)
+
+// IntegerOf returns the namespace for all check.StepOf which assumes the target is of integer type I. Besides
+// everything in Of, it checks for divisibility.
+//
+//	check.ThatOf(quantity, numz.IntegerOf[int]().StepFrom(10, 10))
+func IntegerOf[I Integer]() IntegerTyped[I] {
+	return IntegerTyped[I]{Typed: Of[I]()}
+}
+
+// IntegerTyped is the namespace for check.StepOf with respect to integer type I. Use IntegerOf to obtain an
+// instance.
+type IntegerTyped[I Integer] struct {
+	Typed[I]
+}
+
+// MultipleOf returns a check.StepOf that checks the target is a multiple of the divisor, or returns ErrMultipleOf
+// with the "divisor" parameter. Zero is a multiple of everything, and the only multiple of zero.
+func (IntegerTyped[I]) MultipleOf(divisor I) check.StepOf[I] {
+	return func(target I) error {
+		if arith.MultipleOf(target, divisor) {
+			return nil
+		}
+		return check.NewError(ErrMultipleOf, CodeMultipleOf, target, check.Params{"divisor": divisor})
+	}
+}
+
+// StepFrom returns a check.StepOf that checks the target is one of base, base+step, base+2*step and so on, or
+// returns ErrStepFrom with the "base" and "step" parameters. A negative step counts downwards from base.
+//
+//	numz.IntegerOf[int]().StepFrom(10, 10)	// 10, 20, 30...
+func (IntegerTyped[I]) StepFrom(base I, step I) check.StepOf[I] {
+	return func(target I) error {
+		if arith.StepFrom(target, base, step) {
+			return nil
+		}
+		return check.NewError(ErrStepFrom, CodeStepFrom, target, check.Params{"base": base, "step": step})
+	}
+}
@@ -0,0 +1,47 @@
+package numz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/numz"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestIntegerOf(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "multiple of", run: check.ThatOf(15, numz.IntegerOf[int]().MultipleOf(5))},
+		{name: "negative multiple of", run: check.ThatOf(-15, numz.IntegerOf[int]().MultipleOf(5))},
+		{name: "not multiple of", err: numz.ErrMultipleOf, run: check.ThatOf(16, numz.IntegerOf[int]().MultipleOf(5))},
+		{name: "zero multiple of zero", run: check.ThatOf(0, numz.IntegerOf[int]().MultipleOf(0))},
+		{name: "not multiple of zero", err: numz.ErrMultipleOf, run: check.ThatOf(1, numz.IntegerOf[int]().MultipleOf(0))},
+		{name: "min int multiple of -1", run: check.ThatOf(int8(math.MinInt8), numz.IntegerOf[int8]().MultipleOf(-1))},
+		{name: "step from base", run: check.ThatOf(10, numz.IntegerOf[int]().StepFrom(10, 10))},
+		{name: "step from", run: check.ThatOf(30, numz.IntegerOf[int]().StepFrom(10, 10))},
+		{name: "not on step", err: numz.ErrStepFrom, run: check.ThatOf(25, numz.IntegerOf[int]().StepFrom(10, 10))},
+		{name: "below base", err: numz.ErrStepFrom, run: check.ThatOf(0, numz.IntegerOf[int]().StepFrom(10, 10))},
+		{name: "negative base", run: check.ThatOf(-1, numz.IntegerOf[int]().StepFrom(-7, 3))},
+		{name: "negative step", run: check.ThatOf(-5, numz.IntegerOf[int]().StepFrom(1, -3))},
+		{name: "negative step above base", err: numz.ErrStepFrom, run: check.ThatOf(4, numz.IntegerOf[int]().StepFrom(1, -3))},
+		{name: "zero step", err: numz.ErrStepFrom, run: check.ThatOf(2, numz.IntegerOf[int]().StepFrom(1, 0))},
+		{name: "no overflow", run: check.ThatOf(int8(125), numz.IntegerOf[int8]().StepFrom(-127, 4))},
+		{name: "unsigned", run: check.ThatOf(uint8(250), numz.IntegerOf[uint8]().StepFrom(10, 10))},
+		{name: "unsigned below base", err: numz.ErrStepFrom, run: check.ThatOf(uint8(0), numz.IntegerOf[uint8]().StepFrom(10, 10))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.run()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
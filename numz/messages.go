@@ -0,0 +1,28 @@
+package numz
+
+import "github.com/imulab/check/catalog"
+
+// Messages maps the codes of this package to their English message templates. It is registered into catalog when
+// the package is initialized.
+var Messages = map[string]string{
+	CodeEquals:               "must equal {expected}",
+	CodeNotEqual:             "must not equal {unexpected}",
+	CodeInRange:              "must be at least {start} and less than {end}",
+	CodeGreaterThan:          "must be greater than {bound}",
+	CodeLessThan:             "must be less than {bound}",
+	CodeGreaterThanOrEqualTo: "must be greater than or equal to {bound}",
+	CodeLessThanOrEqualTo:    "must be less than or equal to {bound}",
+	CodeWithin:               "must be within {range}",
+	CodeMultipleOf:           "must be a multiple of {divisor}",
+	CodeStepFrom:             "must be {base} plus a multiple of {step}",
+	CodeDecimalPlaces:        "must have at most {places} decimal places",
+	CodePrecision:            "must have at most {precision} significant digits",
+	CodeDecimal:              "must be a decimal number",
+	CodeNotNaN:               "must be a number",
+	CodeNotInf:               "must not be infinite",
+	CodeFinite:               "must be a finite number",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
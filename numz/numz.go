@@ -0,0 +1,55 @@
+package numz
+
+import (
+	"errors"
+	"github.com/imulab/check/internal/constraints"
+)
+
+// Integer is the constraint for all built-in integer types.
+type Integer = constraints.Integer
+
+// Float is the constraint for all built-in floating point types.
+type Float = constraints.Float
+
+// Number is the constraint for all built-in integer and floating point types.
+type Number interface {
+	Integer | Float
+}
+
+var (
+	ErrEquals               = errors.New("number does not equal to expected value")
+	ErrNotEqual             = errors.New("number equals unexpected value")
+	ErrInRange              = errors.New("number is not in range")
+	ErrGreaterThan          = errors.New("number is not greater than expected value")
+	ErrLessThan             = errors.New("number is not less than expected value")
+	ErrGreaterThanOrEqualTo = errors.New("number is less than expected value")
+	ErrLessThanOrEqualTo    = errors.New("number is greater than expected value")
+	ErrNaN                  = errors.New("number is NaN")
+	ErrInf                  = errors.New("number is infinite")
+	ErrFinite               = errors.New("number is not finite")
+	ErrMultipleOf           = errors.New("number is not a multiple of expected value")
+	ErrStepFrom             = errors.New("number is not on expected step")
+	ErrDecimalPlaces        = errors.New("number has too many decimal places")
+	ErrPrecision            = errors.New("number has too many significant digits")
+	ErrDecimal              = errors.New("string is not a decimal number")
+)
+
+// Codes of the check.Error returned by the check.StepOf in this package.
+const (
+	CodeEquals               = "number.equals"
+	CodeNotEqual             = "number.not_equal"
+	CodeInRange              = "number.in_range"
+	CodeWithin               = "number.within"
+	CodeGreaterThan          = "number.greater_than"
+	CodeLessThan             = "number.less_than"
+	CodeGreaterThanOrEqualTo = "number.greater_than_or_equal_to"
+	CodeLessThanOrEqualTo    = "number.less_than_or_equal_to"
+	CodeNotNaN               = "number.not_nan"
+	CodeNotInf               = "number.not_inf"
+	CodeFinite               = "number.finite"
+	CodeMultipleOf           = "number.multiple_of"
+	CodeStepFrom             = "number.step_from"
+	CodeDecimalPlaces        = "number.decimal_places"
+	CodePrecision            = "number.precision"
+	CodeDecimal              = "number.decimal"
+)
@@ -0,0 +1,125 @@
+package numz
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+)
+
+// Of returns the namespace for all check.StepOf which assumes the target is of number type N.
+//
+//	type Port uint16
+//	check.ThatOf(port, numz.Of[Port]().InRange(1, 1024))
+func Of[N Number]() Typed[N] {
+	t := Typed[N]{}
+	t.Zero = t.Equals(0)
+	t.Positive = t.GreaterThan(0)
+	t.Negative = t.LessThan(0)
+	t.NonPositive = t.LessThanOrEqualTo(0)
+	t.NonNegative = t.GreaterThanOrEqualTo(0)
+	return t
+}
+
+// Typed is the namespace for check.StepOf with respect to number type N. Use Of to obtain an instance.
+//
+// For unsigned types, Negative never passes and NonNegative always does.
+type Typed[N Number] struct {
+	// Zero is a convenient check.StepOf to check equality to 0
+	Zero check.StepOf[N]
+	// Positive is a convenient check.StepOf to check greater than 0
+	Positive check.StepOf[N]
+	// Negative is a convenient check.StepOf to check less than 0
+	Negative check.StepOf[N]
+	// NonPositive is a convenient check.StepOf to check less than or equal to 0
+	NonPositive check.StepOf[N]
+	// NonNegative is a convenient check.StepOf to check greater than or equal to 0
+	NonNegative check.StepOf[N]
+}
+
+// Equals returns a check.StepOf that checks the target equals the expected value, or returns ErrEquals.
+func (Typed[N]) Equals(expected N) check.StepOf[N] {
+	return func(target N) error {
+		if expected == target {
+			return nil
+		}
+		return check.NewError(ErrEquals, CodeEquals, target, check.Params{"expected": expected})
+	}
+}
+
+// NotEqual returns a check.StepOf that checks the target does not equal the value, or returns ErrNotEqual.
+func (Typed[N]) NotEqual(unexpected N) check.StepOf[N] {
+	return func(target N) error {
+		if unexpected != target {
+			return nil
+		}
+		return check.NewError(ErrNotEqual, CodeNotEqual, target, check.Params{"unexpected": unexpected})
+	}
+}
+
+// InRange returns a check.StepOf that checks the target is in the range of an inclusive start value and an
+// exclusive end value, like int64z.InRange, or returns ErrInRange.
+func (Typed[N]) InRange(startInclusive N, endExclusive N) check.StepOf[N] {
+	return func(target N) error {
+		if startInclusive <= target && target < endExclusive {
+			return nil
+		}
+		return check.NewError(ErrInRange, CodeInRange, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// Within returns a check.StepOf that checks the target is in the rangez.Range, or returns ErrInRange with the
+// "range" parameter. NaN is never within a range.
+//
+//	numz.FloatOf[float64]().Within(rangez.OpenClosed(0.0, 1))
+func (Typed[N]) Within(r rangez.Range[N]) check.StepOf[N] {
+	return func(target N) error {
+		if r.Contains(target) {
+			return nil
+		}
+		return check.NewError(ErrInRange, CodeWithin, target, check.Params{"range": r})
+	}
+}
+
+// GreaterThan returns a check.StepOf that checks the target is greater than the bound, or returns ErrGreaterThan.
+func (Typed[N]) GreaterThan(bound N) check.StepOf[N] {
+	return func(target N) error {
+		if target > bound {
+			return nil
+		}
+		return check.NewError(ErrGreaterThan, CodeGreaterThan, target, check.Params{"bound": bound})
+	}
+}
+
+// LessThan returns a check.StepOf that checks the target is less than the bound, or returns ErrLessThan.
+func (Typed[N]) LessThan(bound N) check.StepOf[N] {
+	return func(target N) error {
+		if target < bound {
+			return nil
+		}
+		return check.NewError(ErrLessThan, CodeLessThan, target, check.Params{"bound": bound})
+	}
+}
+
+// GreaterThanOrEqualTo returns a check.StepOf that checks the target is greater than or equal to the bound, or
+// returns ErrGreaterThanOrEqualTo.
+func (Typed[N]) GreaterThanOrEqualTo(bound N) check.StepOf[N] {
+	return func(target N) error {
+		if target >= bound {
+			return nil
+		}
+		return check.NewError(ErrGreaterThanOrEqualTo, CodeGreaterThanOrEqualTo, target, check.Params{"bound": bound})
+	}
+}
+
+// LessThanOrEqualTo returns a check.StepOf that checks the target is less than or equal to the bound, or returns
+// ErrLessThanOrEqualTo.
+func (Typed[N]) LessThanOrEqualTo(bound N) check.StepOf[N] {
+	return func(target N) error {
+		if target <= bound {
+			return nil
+		}
+		return check.NewError(ErrLessThanOrEqualTo, CodeLessThanOrEqualTo, target, check.Params{"bound": bound})
+	}
+}
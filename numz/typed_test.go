@@ -0,0 +1,65 @@
+package numz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/numz"
+	"github.com/imulab/check/rangez"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type port uint16
+
+func TestOf(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{name: "int in range", run: check.ThatOf(1, numz.Of[int]().InRange(1, 101))},
+		{name: "int not in range", err: numz.ErrInRange, run: check.ThatOf(101, numz.Of[int]().InRange(1, 101))},
+		{name: "int8 negative", run: check.ThatOf(int8(-128), numz.Of[int8]().Negative)},
+		{name: "int32 zero", run: check.ThatOf(int32(0), numz.Of[int32]().Zero)},
+		{name: "int32 not zero", err: numz.ErrEquals, run: check.ThatOf(int32(1), numz.Of[int32]().Zero)},
+		{name: "uint positive", run: check.ThatOf(uint(1), numz.Of[uint]().Positive)},
+		{name: "uint not negative", err: numz.ErrLessThan, run: check.ThatOf(uint(0), numz.Of[uint]().Negative)},
+		{name: "named uint16", run: check.ThatOf(port(443), numz.Of[port]().LessThan(1024))},
+		{name: "named uint16 not less than", err: numz.ErrLessThan, run: check.ThatOf(port(8080), numz.Of[port]().LessThan(1024))},
+		{name: "uint64 greater than", run: check.ThatOf(uint64(1<<63), numz.Of[uint64]().GreaterThan(1<<62))},
+		{name: "float32 not equal", run: check.ThatOf(float32(0.1), numz.Of[float32]().NotEqual(0.2))},
+		{name: "float32 equals", err: numz.ErrNotEqual, run: check.ThatOf(float32(0.1), numz.Of[float32]().NotEqual(0.1))},
+		{name: "float64 within", run: check.ThatOf(0.5, numz.Of[float64]().Within(rangez.OpenClosed(0.0, 1)))},
+		{name: "float64 not within", err: numz.ErrInRange, run: check.ThatOf(0.0, numz.Of[float64]().Within(rangez.OpenClosed(0.0, 1)))},
+		{name: "float64 gte", run: check.ThatOf(1.5, numz.Of[float64]().GreaterThanOrEqualTo(1.5))},
+		{name: "float64 not lte", err: numz.ErrLessThanOrEqualTo, run: check.ThatOf(1.5, numz.Of[float64]().LessThanOrEqualTo(1.4))},
+		{name: "float64 non positive", err: numz.ErrLessThanOrEqualTo, run: check.ThatOf(0.01, numz.Of[float64]().NonPositive)},
+		{name: "float64 non negative", run: check.ThatOf(0.0, numz.Of[float64]().NonNegative)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.run()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestOf_Params(t *testing.T) {
+	err := check.ThatOf(port(0), numz.Of[port]().InRange(1, 1024))()
+
+	var checkErr *check.Error
+	if assert.True(t, errors.As(err, &checkErr)) {
+		assert.Equal(t, numz.CodeInRange, checkErr.Code)
+		assert.Equal(t, check.Params{"start": port(1), "end": port(1024)}, checkErr.Params)
+	}
+}
+
+func TestOf_Step(t *testing.T) {
+	assert.NoError(t, check.That(10, numz.Of[int]().Positive.Step())())
+	assert.True(t, errors.Is(check.That(int64(10), numz.Of[int]().Positive.Step())(), check.ErrUnexpectedType))
+}
@@ -0,0 +1,114 @@
+package check
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// Parallel evaluates independent validations concurrently on a bounded pool of workers. It is useful when there
+// are many validations, or individual steps are expensive. The zero value is ready to use.
+//
+//	err := check.Parallel{Workers: 8}.AllErr(
+//		check.That(rows[0].ID, stringz.IsNotEmpty).Path("rows[0].id"),
+//		check.That(rows[1].ID, stringz.IsNotEmpty).Path("rows[1].id"),
+//	)
+//
+// Validations must be safe to run concurrently with each other.
+type Parallel struct {
+	// Workers is the maximum number of validations running at the same time. If it is not positive,
+	// runtime.GOMAXPROCS(0) is used.
+	Workers int
+	// FailFast stops dispatching the remaining validations after the first error, and cancels the context passed
+	// to the validations still running.
+	FailFast bool
+}
+
+// AllErr is the concurrent version of AllErr. Errors are collected into Errors in the order of the supplied
+// ErrFunc, regardless of the order they finished in. If no ErrFunc returned an error, nil is returned.
+//
+// With FailFast, ErrFunc not yet started after the first error are not invoked.
+func (p Parallel) AllErr(ef ...ErrFunc) error {
+	efc := make([]ErrFuncCtx, 0, len(ef))
+	for _, it := range ef {
+		efc = append(efc, it.Ctx())
+	}
+	return p.AllErrCtx(context.Background(), efc...)
+}
+
+// AllErrCtx is like AllErr, but works with ErrFuncCtx. If the context is done before all ErrFuncCtx completed,
+// the context error is returned.
+//
+// With FailFast, the context passed to ErrFuncCtx is canceled after the first error, and the context.Canceled
+// errors returned after that cancellation are not collected. A context.Canceled returned before it, i.e. from a
+// context of the ErrFuncCtx's own, is collected like any other error.
+func (p Parallel) AllErrCtx(ctx context.Context, ef ...ErrFuncCtx) error {
+	var (
+		results     = make([]error, len(ef))
+		induced     = make([]bool, len(ef))
+		jobs        = make(chan int)
+		wg          sync.WaitGroup
+		runCtx, end = context.WithCancel(ctx)
+	)
+	defer end()
+
+	workers := p.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(ef) {
+		workers = len(ef)
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				// The dispatcher may still hand out a job after the first error, as select picks randomly
+				// among ready cases. Such job must not start.
+				if p.FailFast && runCtx.Err() != nil {
+					continue
+				}
+				results[i] = ef[i](runCtx)
+				if results[i] != nil && p.FailFast {
+					// Only a context.Canceled returned after the internal cancellation is caused by it. The
+					// parent context is checked after all workers finished.
+					induced[i] = runCtx.Err() != nil && errors.Is(results[i], context.Canceled)
+					end()
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for i := range ef {
+		if runCtx.Err() != nil {
+			break
+		}
+		select {
+		case jobs <- i:
+		case <-runCtx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var errs Errors
+	for i, err := range results {
+		if err == nil || induced[i] {
+			continue
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
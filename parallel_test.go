@@ -0,0 +1,121 @@
+package check_test
+
+import (
+	"context"
+	"errors"
+	"github.com/imulab/check"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallel_AllErr(t *testing.T) {
+	var (
+		errOne = errors.New("one")
+		errTwo = errors.New("two")
+		ef     []check.ErrFunc
+	)
+	for i := 0; i < 100; i++ {
+		switch i {
+		case 20:
+			ef = append(ef, check.That("foo", wrongStep).Err(errOne))
+		case 80:
+			ef = append(ef, check.That("foo", wrongStep).Err(errTwo))
+		default:
+			ef = append(ef, check.That("foo", correctStep))
+		}
+	}
+
+	for _, workers := range []int{0, 1, 4, 200} {
+		err := check.Parallel{Workers: workers}.AllErr(ef...)
+		assert.Equal(t, check.Errors{errOne, errTwo}, err)
+	}
+
+	assert.NoError(t, check.Parallel{Workers: 4}.AllErr(ef[:10]...))
+	assert.NoError(t, check.Parallel{}.AllErr())
+}
+
+func TestParallel_AllErr_Bounded(t *testing.T) {
+	var running, peak int32
+	var ef []check.ErrFunc
+	for i := 0; i < 20; i++ {
+		ef = append(ef, func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+
+	assert.NoError(t, check.Parallel{Workers: 3}.AllErr(ef...))
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+}
+
+func TestParallel_AllErrCtx_FailFast(t *testing.T) {
+	var (
+		customErr = errors.New("customErr")
+		started   int32
+		ef        []check.ErrFuncCtx
+	)
+	ef = append(ef, check.ThatCtx("foo", wrongStep.Ctx()).Err(customErr))
+	for i := 0; i < 100; i++ {
+		ef = append(ef, func(ctx context.Context) error {
+			atomic.AddInt32(&started, 1)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+				return errors.New("not canceled")
+			}
+		})
+	}
+
+	err := check.Parallel{Workers: 2, FailFast: true}.AllErrCtx(context.Background(), ef...)
+	assert.Equal(t, check.Errors{customErr}, err)
+	assert.Less(t, atomic.LoadInt32(&started), int32(100))
+}
+
+func TestParallel_AllErr_FailFast(t *testing.T) {
+	var (
+		errFirst = errors.New("first")
+		invoked  int32
+		ef       = []check.ErrFunc{check.That("foo", wrongStep).Err(errFirst)}
+	)
+	for i := 0; i < 5; i++ {
+		ef = append(ef, func() error {
+			atomic.AddInt32(&invoked, 1)
+			return errors.New("not skipped")
+		})
+	}
+
+	for i := 0; i < 200; i++ {
+		err := check.Parallel{Workers: 1, FailFast: true}.AllErr(ef...)
+		assert.Equal(t, check.Errors{errFirst}, err)
+	}
+	assert.Zero(t, atomic.LoadInt32(&invoked))
+}
+
+func TestParallel_AllErrCtx_FailFast_OwnCancel(t *testing.T) {
+	own, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := check.Parallel{Workers: 1, FailFast: true}.AllErrCtx(context.Background(), func(ctx context.Context) error {
+		return own.Err()
+	})
+	assert.Equal(t, check.Errors{context.Canceled}, err)
+}
+
+func TestParallel_AllErrCtx_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := check.Parallel{Workers: 2}.AllErrCtx(ctx, check.ThatCtx("foo", correctStep.Ctx()))
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,22 @@
+// Package problem renders check errors as RFC 7807 problem details (application/problem+json), with an "errors"
+// extension member listing every validation failure:
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "Bad Request",
+//	  "status": 400,
+//	  "errors": [
+//	    {"path": "age", "code": "int64.in_range", "message": "must be at least 1 and less than 10", "params": {"start": 1, "end": 10}},
+//	    {"path": "tags[2]", "code": "string.is_not_empty", "message": "must not be empty"}
+//	  ]
+//	}
+//
+// The payload conforms to the JSON schema in Schema. Messages are rendered through a catalog.Renderer, so they can
+// be localized.
+//
+// The target value itself is never part of a Violation, but some params are derived from it: "unexpected",
+// "overlap" and "duplicates" of slicez list elements of the target, and "actual" of jsonz describes the decoded
+// JSON value. When those may hold sensitive input, drop them with Renderer.OmitParams:
+//
+//	r := &problem.Renderer{OmitParams: []string{"unexpected", "overlap", "duplicates", "actual"}}
+package problem
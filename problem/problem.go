@@ -0,0 +1,166 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/catalog"
+	"net/http"
+)
+
+// ContentType is the media type of problem details.
+const ContentType = "application/problem+json"
+
+// Problem is the problem details of a validation failure.
+type Problem struct {
+	Type     string      `json:"type"`
+	Title    string      `json:"title"`
+	Status   int         `json:"status"`
+	Detail   string      `json:"detail,omitempty"`
+	Instance string      `json:"instance,omitempty"`
+	Errors   []Violation `json:"errors"`
+}
+
+// Violation is a single validation failure.
+type Violation struct {
+	// Path is the path to the failing target, i.e. "addresses[2].zip". It is empty when the error is not annotated.
+	Path string `json:"path,omitempty"`
+	// Code is the code of the failing rule, i.e. "int64.in_range". It is empty for errors without code.
+	Code string `json:"code,omitempty"`
+	// Message is the rendered message of the error.
+	Message string `json:"message"`
+	// Params are the parameters of the failing rule.
+	Params check.Params `json:"params,omitempty"`
+}
+
+// Default is the Renderer with default settings.
+var Default = &Renderer{}
+
+// Renderer renders errors into Problem. The zero value is ready to use.
+type Renderer struct {
+	// Type is the problem type URI. Defaults to "about:blank".
+	Type string
+	// Title is the summary of the problem type. Defaults to the status text of Status.
+	Title string
+	// Status is the HTTP status code. Defaults to 400.
+	Status int
+	// Messages renders the message of each violation. Defaults to catalog.Default.
+	Messages *catalog.Renderer
+	// OmitParams names the params left out of every Violation. Messages are still rendered with all params.
+	OmitParams []string
+}
+
+// From renders the error with the Default Renderer.
+func From(err error, locales ...string) *Problem {
+	return Default.From(err, locales...)
+}
+
+// Write writes the error with the Default Renderer.
+func Write(w http.ResponseWriter, err error, locales ...string) error {
+	return Default.Write(w, err, locales...)
+}
+
+// From renders the error, which may be a single error or an aggregate check.Errors, into Problem. Messages are
+// rendered in the requested locales (see catalog.Renderer.Message).
+func (r *Renderer) From(err error, locales ...string) *Problem {
+	p := &Problem{
+		Type:   r.Type,
+		Title:  r.Title,
+		Status: r.Status,
+		Errors: []Violation{},
+	}
+	if len(p.Type) == 0 {
+		p.Type = "about:blank"
+	}
+	if p.Status == 0 {
+		p.Status = http.StatusBadRequest
+	}
+	if len(p.Title) == 0 {
+		p.Title = http.StatusText(p.Status)
+	}
+
+	messages := r.Messages
+	if messages == nil {
+		messages = catalog.Default
+	}
+	r.flatten(messages, "", err, locales, &p.Errors)
+
+	return p
+}
+
+// Write renders the error and writes it to the response as application/problem+json, with the status of the
+// Problem.
+func (r *Renderer) Write(w http.ResponseWriter, err error, locales ...string) error {
+	p := r.From(err, locales...)
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
+
+func (r *Renderer) flatten(messages *catalog.Renderer, path string, err error, locales []string, violations *[]Violation) {
+	if err == nil {
+		return
+	}
+	switch e := annotation(err).(type) {
+	case check.Errors:
+		for _, it := range e {
+			r.flatten(messages, path, it, locales, violations)
+		}
+	case *check.PathError:
+		// compose with the outer path, in case the error is annotated more than once
+		composed := check.WithPath(path, e).(*check.PathError)
+		r.flatten(messages, composed.Path, composed.Err, locales, violations)
+	default:
+		v := Violation{Path: path, Message: messages.Message(err, locales...)}
+		var (
+			orErr    *check.OrError
+			typeErr  *check.TypeError
+			checkErr *check.Error
+		)
+		switch {
+		case errors.As(err, &orErr):
+			v.Code = check.CodeOr
+		case errors.As(err, &typeErr):
+			v.Code = check.CodeUnexpectedType
+			v.Params = check.Params{"expected": typeErr.Expected, "actual": typeErr.Actual}
+		case errors.As(err, &checkErr):
+			v.Code = checkErr.Code
+			v.Params = checkErr.Params
+		}
+		v.Params = r.omit(v.Params)
+		*violations = append(*violations, v)
+	}
+}
+
+// annotation returns the outermost check.Errors or *check.PathError in the chain of err, so that wrapping i.e. by
+// fmt.Errorf keeps the paths. It returns nil if a described error comes first, as an OrError unwraps to check.Errors
+// as well.
+func annotation(err error) error {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		switch e.(type) {
+		case check.Errors, *check.PathError:
+			return e
+		case *check.OrError, *check.TypeError, *check.Error:
+			return nil
+		}
+	}
+	return nil
+}
+
+// omit returns a copy of params without OmitParams, leaving the params of the original error untouched.
+func (r *Renderer) omit(params check.Params) check.Params {
+	if len(r.OmitParams) == 0 || len(params) == 0 {
+		return params
+	}
+	kept := check.Params{}
+	for k, v := range params {
+		kept[k] = v
+	}
+	for _, k := range r.OmitParams {
+		delete(kept, k)
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
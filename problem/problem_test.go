@@ -0,0 +1,148 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/imulab/check"
+	"github.com/imulab/check/catalog"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/problem"
+	"github.com/imulab/check/slicez"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFrom(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		expect []problem.Violation
+	}{
+		{
+			name: "single",
+			err:  check.That(int64(12), int64z.InRange(1, 10)).Path("age")(),
+			expect: []problem.Violation{
+				{
+					Path:    "age",
+					Code:    int64z.CodeInRange,
+					Message: "must be at least 1 and less than 10",
+					Params:  check.Params{"start": int64(1), "end": int64(10)},
+				},
+			},
+		},
+		{
+			name: "aggregate",
+			err: check.AllErr(
+				check.That("", stringz.IsNotEmpty).Path("name"),
+				check.That([]string{"a", ""}, slicez.OfString.All(stringz.IsNotEmpty)).Path("tags"),
+			),
+			expect: []problem.Violation{
+				{Path: "name", Code: stringz.CodeIsNotEmpty, Message: "must not be empty"},
+				{Path: "tags[1]", Code: stringz.CodeIsNotEmpty, Message: "must not be empty"},
+			},
+		},
+		{
+			name: "type error",
+			err:  check.That(1, stringz.IsEmpty)(),
+			expect: []problem.Violation{
+				{
+					Code:    check.CodeUnexpectedType,
+					Message: "expected type string, got int",
+					Params:  check.Params{"expected": "string", "actual": "int"},
+				},
+			},
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("request: %w", check.AllErr(check.That("", stringz.IsNotEmpty).Path("name"))),
+			expect: []problem.Violation{
+				{Path: "name", Code: stringz.CodeIsNotEmpty, Message: "must not be empty"},
+			},
+		},
+		{
+			name:   "wrapped path",
+			err:    fmt.Errorf("request: %w", check.That("", stringz.IsNotEmpty).Path("name")()),
+			expect: []problem.Violation{{Path: "name", Code: stringz.CodeIsNotEmpty, Message: "must not be empty"}},
+		},
+		{
+			name:   "plain error",
+			err:    errors.New("custom"),
+			expect: []problem.Violation{{Message: "custom"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := problem.From(c.err)
+			assert.Equal(t, "about:blank", p.Type)
+			assert.Equal(t, "Bad Request", p.Title)
+			assert.Equal(t, http.StatusBadRequest, p.Status)
+			assert.Equal(t, c.expect, p.Errors)
+		})
+	}
+}
+
+func TestRenderer_Write(t *testing.T) {
+	french := catalog.New()
+	french.Set("fr", stringz.CodeIsNotEmpty, "ne doit pas être vide")
+
+	renderer := &problem.Renderer{
+		Type:     "https://example.com/problems/validation",
+		Title:    "Validation failed",
+		Status:   http.StatusUnprocessableEntity,
+		Messages: catalog.NewRenderer(french),
+	}
+
+	rec := httptest.NewRecorder()
+	assert.NoError(t, renderer.Write(rec, check.That("", stringz.IsNotEmpty).Path("name")(), "fr"))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, problem.ContentType, rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "https://example.com/problems/validation",
+		"title": "Validation failed",
+		"status": 422,
+		"errors": [{"path": "name", "code": "string.is_not_empty", "message": "ne doit pas être vide"}]
+	}`, rec.Body.String())
+}
+
+func TestRenderer_OmitParams(t *testing.T) {
+	err := check.That([]string{"root", "alice"}, slicez.OfString.Disjoint("root", "admin"))()
+
+	renderer := &problem.Renderer{OmitParams: []string{"overlap"}}
+	p := renderer.From(err)
+
+	if assert.Len(t, p.Errors, 1) {
+		assert.Equal(t, slicez.CodeDisjoint, p.Errors[0].Code)
+		assert.Equal(t, check.Params{"values": []string{"root", "admin"}}, p.Errors[0].Params)
+	}
+
+	var checkErr *check.Error
+	if assert.True(t, errors.As(err, &checkErr)) {
+		assert.Equal(t, []string{"root"}, checkErr.Params["overlap"])
+	}
+}
+
+func TestSchema(t *testing.T) {
+	var schema struct {
+		Required   []string                   `json:"required"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(problem.Schema), &schema))
+
+	payload, err := json.Marshal(problem.From(errors.New("custom")))
+	assert.NoError(t, err)
+
+	var members map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(payload, &members))
+	for _, it := range schema.Required {
+		assert.Contains(t, members, it)
+	}
+	for it := range members {
+		assert.Contains(t, schema.Properties, it)
+	}
+}
@@ -0,0 +1,31 @@
+package problem
+
+// Schema is the JSON schema (draft 2020-12) of the Problem payload. It is stable: members may be added in future
+// versions, but existing members will not be removed or change meaning.
+const Schema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/imulab/check/problem/schema.json",
+  "title": "Validation problem details",
+  "type": "object",
+  "required": ["type", "title", "status", "errors"],
+  "properties": {
+    "type": {"type": "string", "format": "uri-reference"},
+    "title": {"type": "string"},
+    "status": {"type": "integer", "minimum": 400, "maximum": 599},
+    "detail": {"type": "string"},
+    "instance": {"type": "string", "format": "uri-reference"},
+    "errors": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["message"],
+        "properties": {
+          "path": {"type": "string"},
+          "code": {"type": "string"},
+          "message": {"type": "string"},
+          "params": {"type": "object"}
+        }
+      }
+    }
+  }
+}`
@@ -0,0 +1,15 @@
+// Package rangez builds intervals of ordered values, which may be closed, open, half-open or unbounded on either
+// side. A Range is accepted by the Within and HasLengthWithin check.Step of the numeric, string, slice and map
+// packages, and prints itself in mathematical notation.
+//
+//	rangez.Closed(1, 100)      // [1, 100]
+//	rangez.ClosedOpen(0, 1.0)  // [0, 1)
+//	rangez.AtLeast(18)         // [18, +∞)
+//
+//	check.That(age, int64z.Within(rangez.Closed[int64](18, 150)))
+//	check.That(name, stringz.HasLengthWithin(rangez.Closed(1, 64)))
+//
+// Failures are *check.Error carrying the Range in the "range" parameter. Like any check.Error, its Error method
+// only returns the text of the sentinel, i.e. "int64 value is not in range". Read the bounds from Params, or render
+// the error through the catalog package for a message like "must be within [1, 100]".
+package rangez
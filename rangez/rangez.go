@@ -0,0 +1,115 @@
+package rangez
+
+import (
+	"fmt"
+	"github.com/imulab/check/internal/constraints"
+	"strings"
+)
+
+// Ordered is the constraint for types supporting the < operator.
+type Ordered = constraints.Ordered
+
+// Range is an interval of values of type T. The zero value is the unbounded range, same as All. Use the builder
+// functions, i.e. Closed, to obtain an instance.
+type Range[T Ordered] struct {
+	lower bound[T]
+	upper bound[T]
+}
+
+type bound[T Ordered] struct {
+	value     T
+	bounded   bool
+	inclusive bool
+}
+
+// Closed returns the range [lower, upper], which includes both ends.
+func Closed[T Ordered](lower T, upper T) Range[T] {
+	return Range[T]{lower: bound[T]{lower, true, true}, upper: bound[T]{upper, true, true}}
+}
+
+// Open returns the range (lower, upper), which excludes both ends.
+func Open[T Ordered](lower T, upper T) Range[T] {
+	return Range[T]{lower: bound[T]{lower, true, false}, upper: bound[T]{upper, true, false}}
+}
+
+// ClosedOpen returns the range [lower, upper), which includes the lower end only. It is the range of
+// int64z.InRange.
+func ClosedOpen[T Ordered](lower T, upper T) Range[T] {
+	return Range[T]{lower: bound[T]{lower, true, true}, upper: bound[T]{upper, true, false}}
+}
+
+// OpenClosed returns the range (lower, upper], which includes the upper end only.
+func OpenClosed[T Ordered](lower T, upper T) Range[T] {
+	return Range[T]{lower: bound[T]{lower, true, false}, upper: bound[T]{upper, true, true}}
+}
+
+// AtLeast returns the range [lower, +∞).
+func AtLeast[T Ordered](lower T) Range[T] {
+	return Range[T]{lower: bound[T]{lower, true, true}}
+}
+
+// GreaterThan returns the range (lower, +∞).
+func GreaterThan[T Ordered](lower T) Range[T] {
+	return Range[T]{lower: bound[T]{lower, true, false}}
+}
+
+// AtMost returns the range (-∞, upper].
+func AtMost[T Ordered](upper T) Range[T] {
+	return Range[T]{upper: bound[T]{upper, true, true}}
+}
+
+// LessThan returns the range (-∞, upper).
+func LessThan[T Ordered](upper T) Range[T] {
+	return Range[T]{upper: bound[T]{upper, true, false}}
+}
+
+// All returns the range (-∞, +∞), which contains every value except NaN.
+func All[T Ordered]() Range[T] {
+	return Range[T]{}
+}
+
+// Contains returns true if the value is in the range. NaN is not contained in any range.
+func (r Range[T]) Contains(value T) bool {
+	if value != value { // NaN
+		return false
+	}
+	if b := r.lower; b.bounded && (value < b.value || (!b.inclusive && value == b.value)) {
+		return false
+	}
+	if b := r.upper; b.bounded && (value > b.value || (!b.inclusive && value == b.value)) {
+		return false
+	}
+	return true
+}
+
+// String formats the range in mathematical notation, i.e. "[1, 100]" or "(-∞, 0)".
+func (r Range[T]) String() string {
+	var sb strings.Builder
+	if r.lower.bounded && r.lower.inclusive {
+		sb.WriteString("[")
+	} else {
+		sb.WriteString("(")
+	}
+	if r.lower.bounded {
+		sb.WriteString(fmt.Sprint(r.lower.value))
+	} else {
+		sb.WriteString("-∞")
+	}
+	sb.WriteString(", ")
+	if r.upper.bounded {
+		sb.WriteString(fmt.Sprint(r.upper.value))
+	} else {
+		sb.WriteString("+∞")
+	}
+	if r.upper.bounded && r.upper.inclusive {
+		sb.WriteString("]")
+	} else {
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+// MarshalText encodes the range as its String, so that it reads the same in JSON, i.e. problem details.
+func (r Range[T]) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
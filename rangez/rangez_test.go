@@ -0,0 +1,111 @@
+package rangez_test
+
+import (
+	"encoding/json"
+	"github.com/imulab/check/rangez"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	cases := []struct {
+		name  string
+		r     rangez.Range[float64]
+		str   string
+		in    []float64
+		notIn []float64
+	}{
+		{
+			name:  "closed",
+			r:     rangez.Closed(1.0, 100),
+			str:   "[1, 100]",
+			in:    []float64{1, 50, 100},
+			notIn: []float64{0.99, 100.01, math.NaN()},
+		},
+		{
+			name:  "open",
+			r:     rangez.Open(1.0, 100),
+			str:   "(1, 100)",
+			in:    []float64{1.01, 99.99},
+			notIn: []float64{1, 100},
+		},
+		{
+			name:  "closed open",
+			r:     rangez.ClosedOpen(0, 1.5),
+			str:   "[0, 1.5)",
+			in:    []float64{0, 1.49},
+			notIn: []float64{-0.01, 1.5},
+		},
+		{
+			name:  "open closed",
+			r:     rangez.OpenClosed(0, 1.5),
+			str:   "(0, 1.5]",
+			in:    []float64{0.01, 1.5},
+			notIn: []float64{0, 1.51},
+		},
+		{
+			name:  "at least",
+			r:     rangez.AtLeast(18.0),
+			str:   "[18, +∞)",
+			in:    []float64{18, math.Inf(1)},
+			notIn: []float64{17.9, math.Inf(-1)},
+		},
+		{
+			name:  "greater than",
+			r:     rangez.GreaterThan(0.0),
+			str:   "(0, +∞)",
+			in:    []float64{0.01},
+			notIn: []float64{0},
+		},
+		{
+			name:  "at most",
+			r:     rangez.AtMost(0.0),
+			str:   "(-∞, 0]",
+			in:    []float64{0, math.Inf(-1)},
+			notIn: []float64{0.01},
+		},
+		{
+			name:  "less than",
+			r:     rangez.LessThan(0.0),
+			str:   "(-∞, 0)",
+			in:    []float64{-0.01},
+			notIn: []float64{0},
+		},
+		{
+			name:  "all",
+			r:     rangez.All[float64](),
+			str:   "(-∞, +∞)",
+			in:    []float64{math.Inf(-1), 0, math.Inf(1)},
+			notIn: []float64{math.NaN()},
+		},
+		{
+			name:  "empty",
+			r:     rangez.Closed(1.0, 0),
+			str:   "[1, 0]",
+			notIn: []float64{0, 0.5, 1},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.str, c.r.String())
+			for _, it := range c.in {
+				assert.True(t, c.r.Contains(it), "%v should be in %s", it, c.r)
+			}
+			for _, it := range c.notIn {
+				assert.False(t, c.r.Contains(it), "%v should not be in %s", it, c.r)
+			}
+		})
+	}
+}
+
+func TestRange_String(t *testing.T) {
+	r := rangez.ClosedOpen("a", "n")
+	assert.True(t, r.Contains("m"))
+	assert.False(t, r.Contains("n"))
+
+	b, err := json.Marshal(map[string]interface{}{"range": r})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"range": "[a, n)"}`, string(b))
+}
@@ -6,5 +6,18 @@
 //	slicez.OfString.HasLength(5)
 //	slicez.OfString.All(stringz.IsNotEmpty)
 //
-// Currently, only string slice is supported.
+// Type-safe check.StepOf for slices of any element type are available via the
+// generic namespace Of. On top of it, ComparableOf adds Contains, NotContain and
+// set relations such as SubsetOf for comparable elements, OrderedOf adds order
+// checks for numbers and strings, and StringsOf covers string slices, including
+// named string slice types. Element check.StepOf from other packages compose
+// naturally.
+//
+//	slicez.Of[[]int64]().All(int64z.Of[int64]().Positive)
+//	slicez.ComparableOf[[]int]().Contains(443)
+//	slicez.OrderedOf[[]int64]().StrictlyAscending
+//	slicez.StringsOf[[]Email]().All(stringz.Of[Email]().IsNotEmpty)
+//
+// Failures are reported as *check.Error with one of the Code constants, except
+// for All, which returns the element error annotated with its index.
 package slicez
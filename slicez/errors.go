@@ -3,12 +3,49 @@ package slicez
 import "errors"
 
 var (
-	ErrIsEmpty          = errors.New("slice is not empty")
-	ErrIsNotEmpty       = errors.New("slice is empty")
+	ErrIsEmpty          = errors.New("slice is empty")
+	ErrIsNotEmpty       = errors.New("slice is not empty")
 	ErrHasLength        = errors.New("slice does not have expected length")
 	ErrHasLengthInRange = errors.New("slice does not have length in expected range")
 	ErrContains         = errors.New("slice does not contain expected value")
 	ErrNotContain       = errors.New("slice contains unexpected value")
-	ErrAny              = errors.New("none of the slice elements meets to condition")
-	ErrNone             = errors.New("some of the slice elements meets condition")
+	ErrAny              = errors.New("none of the slice elements meets the condition")
+	ErrNone             = errors.New("some of the slice elements meets the condition")
+	ErrUnique           = errors.New("slice contains duplicate elements")
+	ErrAtLeast          = errors.New("too few slice elements meet the condition")
+	ErrAtMost           = errors.New("too many slice elements meet the condition")
+	ErrExactly          = errors.New("slice elements meeting the condition are not of expected count")
+	ErrNotSorted        = errors.New("slice elements are not in expected order")
+	ErrSubsetOf         = errors.New("slice contains values outside of expected set")
+	ErrSupersetOf       = errors.New("slice does not include all of expected set")
+	ErrContainsAll      = errors.New("slice does not contain all expected values")
+	ErrContainsAny      = errors.New("slice does not contain any expected value")
+	ErrDisjoint         = errors.New("slice contains excluded values")
+)
+
+// Codes of the check.Error returned by the check.Step in this package.
+const (
+	CodeIsEmpty            = "slice.is_empty"
+	CodeIsNotEmpty         = "slice.is_not_empty"
+	CodeHasLength          = "slice.has_length"
+	CodeHasLengthInRange   = "slice.has_length_in_range"
+	CodeContains           = "slice.contains"
+	CodeNotContain         = "slice.not_contain"
+	CodeAny                = "slice.any"
+	CodeNone               = "slice.none"
+	CodeUnique             = "slice.unique"
+	CodeAtLeast            = "slice.at_least"
+	CodeAtMost             = "slice.at_most"
+	CodeExactly            = "slice.exactly"
+	CodeAscending          = "slice.ascending"
+	CodeDescending         = "slice.descending"
+	CodeStrictlyAscending  = "slice.strictly_ascending"
+	CodeStrictlyDescending = "slice.strictly_descending"
+	CodeSortedBy           = "slice.sorted_by"
+	CodeSubsetOf           = "slice.subset_of"
+	CodeSupersetOf         = "slice.superset_of"
+	CodeContainsAll        = "slice.contains_all"
+	CodeContainsAny        = "slice.contains_any"
+	CodeDisjoint           = "slice.disjoint"
+	CodeHasLengthWithin    = "slice.has_length_within"
 )
@@ -0,0 +1,35 @@
+package slicez
+
+import "github.com/imulab/check/catalog"
+
+// Messages contains the English message templates of the slice codes, keyed by code. They are registered into
+// catalog on init, and can be overridden by placing another catalog.Translator first.
+var Messages = map[string]string{
+	CodeIsEmpty:            "must be empty",
+	CodeIsNotEmpty:         "must not be empty",
+	CodeHasLength:          "must have {length} elements",
+	CodeHasLengthInRange:   "must have at least {start} and less than {end} elements",
+	CodeContains:           "must contain {value}",
+	CodeNotContain:         "must not contain {value}",
+	CodeAny:                "must have an element meeting the condition",
+	CodeNone:               "must not have any element meeting the condition",
+	CodeUnique:             "must not contain duplicates: {duplicates}",
+	CodeAtLeast:            "must have at least {bound} elements meeting the condition, but has {count}",
+	CodeAtMost:             "must have at most {bound} elements meeting the condition, but has {count}",
+	CodeExactly:            "must have exactly {bound} elements meeting the condition, but has {count}",
+	CodeAscending:          "must be in ascending order, but element {index} is less than element {previous}",
+	CodeDescending:         "must be in descending order, but element {index} is greater than element {previous}",
+	CodeStrictlyAscending:  "must be strictly ascending, but element {index} is not greater than element {previous}",
+	CodeStrictlyDescending: "must be strictly descending, but element {index} is not less than element {previous}",
+	CodeSubsetOf:           "must only contain {values}, but also contains {unexpected}",
+	CodeSupersetOf:         "must include {values}, but is missing {missing}",
+	CodeContainsAll:        "must contain all of {values}, but is missing {missing}",
+	CodeContainsAny:        "must contain at least one of {values}",
+	CodeDisjoint:           "must not contain any of {values}, but contains {overlap}",
+	CodeHasLengthWithin:    "must have a number of elements within {range}",
+	CodeSortedBy:           "must be sorted, but element {index} is out of order with element {previous}",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
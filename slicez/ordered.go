@@ -0,0 +1,74 @@
+package slicez
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/internal/constraints"
+)
+
+// Ordered is the constraint for element types supporting the < operator.
+type Ordered = constraints.Ordered
+
+// OrderedOf is like ComparableOf, but for slices with Ordered elements, which additionally supports checks on the
+// order of the elements.
+//
+//	check.ThatOf(timestamps, slicez.OrderedOf[[]int64]().StrictlyAscending)
+func OrderedOf[S ~[]E, E Ordered]() OrderedTyped[S, E] {
+	return OrderedTyped[S, E]{
+		ComparableTyped: ComparableOf[S](),
+		Ascending: inOrder[S](CodeAscending, func(prev, next E) bool {
+			return prev <= next
+		}),
+		Descending: inOrder[S](CodeDescending, func(prev, next E) bool {
+			return prev >= next
+		}),
+		StrictlyAscending: inOrder[S](CodeStrictlyAscending, func(prev, next E) bool {
+			return prev < next
+		}),
+		StrictlyDescending: inOrder[S](CodeStrictlyDescending, func(prev, next E) bool {
+			return prev > next
+		}),
+	}
+}
+
+// OrderedTyped is the namespace for check.StepOf with respect to slice type S with Ordered elements. Use OrderedOf
+// to obtain an instance.
+//
+// The order checks return ErrNotSorted for the first pair of adjacent elements out of order, whose indexes are
+// reported as the "previous" and "index" parameters.
+type OrderedTyped[S ~[]E, E Ordered] struct {
+	ComparableTyped[S, E]
+	// Ascending is a check.StepOf that verifies no element of the target slice
+	// is less than the one before it, or returns ErrNotSorted.
+	Ascending check.StepOf[S]
+	// Descending is a check.StepOf that verifies no element of the target slice
+	// is greater than the one before it, or returns ErrNotSorted.
+	Descending check.StepOf[S]
+	// StrictlyAscending is a check.StepOf that verifies every element of the target slice
+	// is greater than the one before it, or returns ErrNotSorted.
+	StrictlyAscending check.StepOf[S]
+	// StrictlyDescending is a check.StepOf that verifies every element of the target slice
+	// is less than the one before it, or returns ErrNotSorted.
+	StrictlyDescending check.StepOf[S]
+}
+
+// SortedBy returns check.StepOf that verifies the target slice is sorted according to less, the same function
+// sort.Slice would take, or returns ErrNotSorted for the first element less than the one before it. Equal elements
+// are allowed to be adjacent.
+//
+//	slicez.Of[[]Event]().SortedBy(func(a, b Event) bool { return a.At.Before(b.At) })
+func (Typed[S, E]) SortedBy(less func(a, b E) bool) check.StepOf[S] {
+	return inOrder[S](CodeSortedBy, func(prev, next E) bool {
+		return !less(next, prev)
+	})
+}
+
+func inOrder[S ~[]E, E any](code string, ordered func(prev, next E) bool) check.StepOf[S] {
+	return func(target S) error {
+		for i := 1; i < len(target); i++ {
+			if !ordered(target[i-1], target[i]) {
+				return check.NewError(ErrNotSorted, code, target, check.Params{"previous": i - 1, "index": i})
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package slicez
+
+import "github.com/imulab/check"
+
+// SubsetOf returns check.StepOf that verifies every element of the target slice is one of the values, or returns
+// ErrSubsetOf with the "unexpected" parameter listing the offending elements.
+//
+//	check.ThatOf(requested, slicez.ComparableOf[[]Scope]().SubsetOf(granted...))
+func (ComparableTyped[S, E]) SubsetOf(values ...E) check.StepOf[S] {
+	return func(target S) error {
+		if unexpected := difference(target, values); len(unexpected) > 0 {
+			return check.NewError(ErrSubsetOf, CodeSubsetOf, target, check.Params{
+				"values":     values,
+				"unexpected": unexpected,
+			})
+		}
+		return nil
+	}
+}
+
+// SupersetOf returns check.StepOf that verifies the target slice contains every one of the values, or returns
+// ErrSupersetOf with the "missing" parameter listing the values not found. It is the reverse of SubsetOf.
+func (ComparableTyped[S, E]) SupersetOf(values ...E) check.StepOf[S] {
+	return func(target S) error {
+		if missing := difference(values, target); len(missing) > 0 {
+			return check.NewError(ErrSupersetOf, CodeSupersetOf, target, check.Params{
+				"values":  values,
+				"missing": missing,
+			})
+		}
+		return nil
+	}
+}
+
+// ContainsAll returns check.StepOf that verifies the target slice contains all the values, or returns ErrContainsAll
+// with the "missing" parameter listing the values not found. It is the multi-value counterpart of Contains.
+//
+//	check.ThatOf(fields, slicez.StringsOf[[]string]().ContainsAll("id", "name"))
+func (ComparableTyped[S, E]) ContainsAll(values ...E) check.StepOf[S] {
+	return func(target S) error {
+		if missing := difference(values, target); len(missing) > 0 {
+			return check.NewError(ErrContainsAll, CodeContainsAll, target, check.Params{
+				"values":  values,
+				"missing": missing,
+			})
+		}
+		return nil
+	}
+}
+
+// ContainsAny returns check.StepOf that verifies the target slice contains at least one of the values, or returns
+// ErrContainsAny.
+func (ComparableTyped[S, E]) ContainsAny(values ...E) check.StepOf[S] {
+	return func(target S) error {
+		if len(intersection(target, values)) == 0 {
+			return check.NewError(ErrContainsAny, CodeContainsAny, target, check.Params{"values": values})
+		}
+		return nil
+	}
+}
+
+// Disjoint returns check.StepOf that verifies the target slice shares no element with the values, or returns
+// ErrDisjoint with the "overlap" parameter listing the offending elements.
+//
+//	check.That(names, slicez.OfString.Disjoint(denied...))
+func (ComparableTyped[S, E]) Disjoint(values ...E) check.StepOf[S] {
+	return func(target S) error {
+		if overlap := intersection(target, values); len(overlap) > 0 {
+			return check.NewError(ErrDisjoint, CodeDisjoint, target, check.Params{
+				"values":  values,
+				"overlap": overlap,
+			})
+		}
+		return nil
+	}
+}
+
+// difference returns the distinct elements of a absent from b, in the order they first appear in a.
+func difference[E comparable](a []E, b []E) []E {
+	return filter(a, b, false)
+}
+
+// intersection returns the distinct elements of a present in b, in the order they first appear in a.
+func intersection[E comparable](a []E, b []E) []E {
+	return filter(a, b, true)
+}
+
+func filter[E comparable](a []E, b []E, present bool) []E {
+	set := make(map[E]struct{}, len(b))
+	for _, it := range b {
+		set[it] = struct{}{}
+	}
+
+	var (
+		result []E
+		seen   = make(map[E]struct{})
+	)
+	for _, it := range a {
+		if _, ok := set[it]; ok != present {
+			continue
+		}
+		if _, ok := seen[it]; ok {
+			continue
+		}
+		seen[it] = struct{}{}
+		result = append(result, it)
+	}
+	return result
+}
@@ -0,0 +1,273 @@
+package slicez
+
+import (
+	"fmt"
+	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+)
+
+// Of returns the namespace for all check.StepOf which assumes the target is of slice type S, whose elements are of
+// any type E. E is inferred from S, and the element check.StepOf from other packages compose naturally.
+//
+//	check.ThatOf(ids, slicez.Of[[]int64]().All(int64z.Of[int64]().Positive))
+//	check.ThatOf(users, slicez.Of[[]User]().All(validateUser))
+func Of[S ~[]E, E any]() Typed[S, E] {
+	return Typed[S, E]{
+		IsEmpty: func(target S) error {
+			if len(target) == 0 {
+				return nil
+			}
+			return check.NewError(ErrIsNotEmpty, CodeIsEmpty, target, nil)
+		},
+		IsNotEmpty: func(target S) error {
+			if len(target) > 0 {
+				return nil
+			}
+			return check.NewError(ErrIsEmpty, CodeIsNotEmpty, target, nil)
+		},
+	}
+}
+
+// Typed is the namespace for check.StepOf with respect to slice type S. Use Of to obtain an instance.
+type Typed[S ~[]E, E any] struct {
+	// IsEmpty is a check.StepOf that verifies the target slice
+	// is empty, or returns ErrIsNotEmpty.
+	IsEmpty check.StepOf[S]
+	// IsNotEmpty is a check.StepOf that verifies the target slice
+	// is not empty, or returns ErrIsEmpty.
+	IsNotEmpty check.StepOf[S]
+}
+
+// HasLength returns check.StepOf that verifies the given slice has the expected length, or returns
+// ErrHasLength.
+func (Typed[S, E]) HasLength(length int) check.StepOf[S] {
+	return func(target S) error {
+		if len(target) == length {
+			return nil
+		}
+		return check.NewError(ErrHasLength, CodeHasLength, target, check.Params{"length": length})
+	}
+}
+
+// HasLengthInRange returns check.StepOf that verifies the given slice has the length in the
+// expected range, or returns HasLengthInRange.
+func (Typed[S, E]) HasLengthInRange(startInclusive int, endExclusive int) check.StepOf[S] {
+	return func(target S) error {
+		length := len(target)
+		if startInclusive <= length && length < endExclusive {
+			return nil
+		}
+		return check.NewError(ErrHasLengthInRange, CodeHasLengthInRange, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// HasLengthWithin returns check.StepOf that verifies the length of the given slice is in the rangez.Range, or
+// returns ErrHasLengthInRange with the "range" parameter.
+//
+//	slicez.Of[[]Item]().HasLengthWithin(rangez.Closed(1, 100))
+func (Typed[S, E]) HasLengthWithin(r rangez.Range[int]) check.StepOf[S] {
+	return func(target S) error {
+		if r.Contains(len(target)) {
+			return nil
+		}
+		return check.NewError(ErrHasLengthInRange, CodeHasLengthWithin, target, check.Params{"range": r})
+	}
+}
+
+// All checks all slice elements conform to the condition of the element check.StepOf. If an element
+// check.StepOf returns an error, it is returned as the error, annotated with the index of the element (see
+// check.WithIndex). The element check.StepOf is NOT recommended to use check.Skip.
+func (Typed[S, E]) All(elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		for i, it := range target {
+			if err := elemStep(it); err != nil {
+				return check.WithIndex(i, err)
+			}
+		}
+		return nil
+	}
+}
+
+// AllParallel is like All, but checks the elements concurrently with the check.Parallel runner. Errors of all
+// failing elements (or those found before stopping, with FailFast) are returned as check.Errors in the order of
+// the elements, each annotated with the index of the element. The element check.StepOf must be safe to run
+// concurrently.
+func (Typed[S, E]) AllParallel(p check.Parallel, elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		ef := make([]check.ErrFunc, 0, len(target))
+		for i, it := range target {
+			i, it := i, it
+			ef = append(ef, func() error {
+				return check.WithIndex(i, check.ThatOf(it, elemStep)())
+			})
+		}
+		return p.AllErr(ef...)
+	}
+}
+
+// Any checks if any slice elements conform to the condition of the element check.StepOf. If all element
+// check.StepOf returned error, ErrAny is returned.
+func (Typed[S, E]) Any(elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		for _, it := range target {
+			if err := elemStep(it); err == nil {
+				return nil
+			}
+		}
+		return check.NewError(ErrAny, CodeAny, target, nil)
+	}
+}
+
+// None checks if none slice elements conform to the condition of the element check.StepOf. If any element
+// check.StepOf returned nil, ErrNone is returned.
+func (Typed[S, E]) None(elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		for _, it := range target {
+			if err := elemStep(it); err == nil {
+				return check.NewError(ErrNone, CodeNone, target, nil)
+			}
+		}
+		return nil
+	}
+}
+
+// AtLeast checks at least n slice elements conform to the condition of the element check.StepOf. Otherwise,
+// ErrAtLeast is returned with the "count" parameter of the conforming elements and the "bound" parameter of n.
+//
+//	// This example checks there are at least two approvers.
+//	slicez.Of[[]Reviewer]().AtLeast(2, isApprover)
+func (Typed[S, E]) AtLeast(n int, elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		if count := countOf(target, elemStep); count < n {
+			return check.NewError(ErrAtLeast, CodeAtLeast, target, check.Params{"count": count, "bound": n})
+		}
+		return nil
+	}
+}
+
+// AtMost checks at most n slice elements conform to the condition of the element check.StepOf. Otherwise,
+// ErrAtMost is returned with the "count" parameter of the conforming elements and the "bound" parameter of n.
+func (Typed[S, E]) AtMost(n int, elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		if count := countOf(target, elemStep); count > n {
+			return check.NewError(ErrAtMost, CodeAtMost, target, check.Params{"count": count, "bound": n})
+		}
+		return nil
+	}
+}
+
+// Exactly checks exactly n slice elements conform to the condition of the element check.StepOf. Otherwise,
+// ErrExactly is returned with the "count" parameter of the conforming elements and the "bound" parameter of n.
+func (Typed[S, E]) Exactly(n int, elemStep check.StepOf[E]) check.StepOf[S] {
+	return func(target S) error {
+		if count := countOf(target, elemStep); count != n {
+			return check.NewError(ErrExactly, CodeExactly, target, check.Params{"count": count, "bound": n})
+		}
+		return nil
+	}
+}
+
+// countOf counts the elements for which the element check.StepOf returned nil, like Any and None.
+func countOf[S ~[]E, E any](target S, elemStep check.StepOf[E]) int {
+	count := 0
+	for _, it := range target {
+		if err := elemStep(it); err == nil {
+			count++
+		}
+	}
+	return count
+}
+
+// ComparableOf is like Of, but for slices with comparable elements, which additionally supports Contains and
+// NotContain.
+//
+//	check.ThatOf(ports, slicez.ComparableOf[[]int]().Contains(443))
+func ComparableOf[S ~[]E, E comparable]() ComparableTyped[S, E] {
+	return ComparableTyped[S, E]{
+		Typed: Of[S](),
+		Unique: UniqueBy[S](func(elem E) E {
+			return elem
+		}),
+	}
+}
+
+// ComparableTyped is the namespace for check.StepOf with respect to slice type S with comparable elements. Use
+// ComparableOf to obtain an instance.
+type ComparableTyped[S ~[]E, E comparable] struct {
+	Typed[S, E]
+	// Unique is a check.StepOf that verifies the elements of the target slice are distinct, or returns ErrUnique.
+	// See UniqueBy.
+	Unique check.StepOf[S]
+}
+
+// Contains returns check.StepOf that verifies the target slice contains the expected element, or returns
+// ErrContains.
+func (ComparableTyped[S, E]) Contains(value E) check.StepOf[S] {
+	return func(target S) error {
+		for _, it := range target {
+			if it == value {
+				return nil
+			}
+		}
+		return check.NewError(ErrContains, CodeContains, target, check.Params{"value": value})
+	}
+}
+
+// NotContain returns check.StepOf that verifies the target slice does not contain the element, or returns
+// ErrNotContain.
+func (ComparableTyped[S, E]) NotContain(value E) check.StepOf[S] {
+	return func(target S) error {
+		for _, it := range target {
+			if it == value {
+				return check.NewError(ErrNotContain, CodeNotContain, target, check.Params{"value": value})
+			}
+		}
+		return nil
+	}
+}
+
+// Duplicate reports a value that appears more than once, and the indexes of the elements having it.
+type Duplicate struct {
+	Value   interface{} `json:"value"`
+	Indexes []int       `json:"indexes"`
+}
+
+// String formats the Duplicate, i.e. "foo at [1 3]".
+func (d Duplicate) String() string {
+	return fmt.Sprintf("%v at %v", d.Value, d.Indexes)
+}
+
+// UniqueBy returns check.StepOf that verifies the keys of the elements in the target slice are distinct, or returns
+// ErrUnique with the "duplicates" parameter listing every Duplicate key, in the order the keys first appear. It
+// runs in linear time. E is inferred from S, and K from the key function.
+//
+//	check.ThatOf(users, slicez.UniqueBy[[]User](func(u User) string { return u.Email }))
+func UniqueBy[S ~[]E, E any, K comparable](key func(elem E) K) check.StepOf[S] {
+	return func(target S) error {
+		var (
+			indexes = make(map[K][]int, len(target))
+			keys    []K
+		)
+		for i, it := range target {
+			k := key(it)
+			if _, ok := indexes[k]; !ok {
+				keys = append(keys, k)
+			}
+			indexes[k] = append(indexes[k], i)
+		}
+		if len(keys) == len(target) {
+			return nil
+		}
+
+		var duplicates []Duplicate
+		for _, k := range keys {
+			if len(indexes[k]) > 1 {
+				duplicates = append(duplicates, Duplicate{Value: k, Indexes: indexes[k]})
+			}
+		}
+		return check.NewError(ErrUnique, CodeUnique, target, check.Params{"duplicates": duplicates})
+	}
+}
@@ -0,0 +1,223 @@
+package slicez_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/int64z"
+	"github.com/imulab/check/slicez"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type point struct {
+	X, Y int64
+}
+
+var validPoint check.StepOf[point] = func(target point) error {
+	return check.AllErr(
+		check.That(target.X, int64z.NonNegative).Path("x"),
+		check.That(target.Y, int64z.NonNegative).Path("y"),
+	)
+}
+
+func TestOf(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		path string
+		run  func() error
+	}{
+		{
+			name: "int64 all",
+			run: func() error {
+				return check.ThatOf([]int64{1, 2}, slicez.Of[[]int64]().All(int64z.Of[int64]().Positive))()
+			},
+		},
+		{
+			name: "int64 not all",
+			err:  int64z.ErrGreaterThan,
+			path: "[1]",
+			run: func() error {
+				return check.ThatOf([]int64{1, 0}, slicez.Of[[]int64]().All(int64z.Of[int64]().Positive))()
+			},
+		},
+		{
+			name: "int contains",
+			run: func() error {
+				return check.ThatOf([]int{80, 443}, slicez.ComparableOf[[]int]().Contains(443))()
+			},
+		},
+		{
+			name: "float64 does not contain",
+			err:  slicez.ErrContains,
+			run: func() error {
+				return check.ThatOf([]float64{0.5}, slicez.ComparableOf[[]float64]().Contains(1.5))()
+			},
+		},
+		{
+			name: "bool not contain",
+			err:  slicez.ErrNotContain,
+			run: func() error {
+				return check.ThatOf([]bool{true, false}, slicez.ComparableOf[[]bool]().NotContain(false))()
+			},
+		},
+		{
+			name: "struct length",
+			err:  slicez.ErrHasLengthInRange,
+			run: func() error {
+				return check.ThatOf([]point{}, slicez.Of[[]point]().HasLengthInRange(1, 3))()
+			},
+		},
+		{
+			name: "struct all",
+			err:  int64z.ErrGreaterThanOrEqualTo,
+			path: "points[1].y",
+			run: func() error {
+				return check.ThatOf([]point{{1, 1}, {1, -1}}, slicez.Of[[]point]().All(validPoint)).Path("points")()
+			},
+		},
+		{
+			name: "struct any",
+			run: func() error {
+				return check.ThatOf([]point{{-1, 1}, {1, 1}}, slicez.Of[[]point]().Any(validPoint))()
+			},
+		},
+		{
+			name: "struct none",
+			err:  slicez.ErrNone,
+			run: func() error {
+				return check.ThatOf([]point{{-1, 1}, {1, 1}}, slicez.Of[[]point]().None(validPoint))()
+			},
+		},
+		{
+			name: "untyped element step",
+			err:  stringz.ErrIsNotEmpty,
+			path: "[0]",
+			run: func() error {
+				return check.That([]interface{}{""}, slicez.Of[[]interface{}]().All(check.Typed[interface{}](stringz.IsNotEmpty)).Step())()
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.run()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, c.err))
+			if len(c.path) > 0 {
+				var pathErr *check.PathError
+				if assert.True(t, errors.As(err, &pathErr)) {
+					assert.Equal(t, c.path, pathErr.Path)
+				}
+			}
+		})
+	}
+}
+
+func TestComparableTyped_Unique(t *testing.T) {
+	cases := []struct {
+		name       string
+		target     []string
+		duplicates []slicez.Duplicate
+	}{
+		{name: "empty", target: nil},
+		{name: "unique", target: []string{"a", "b", "c"}},
+		{
+			name:   "duplicates",
+			target: []string{"a", "b", "a", "c", "b", "a"},
+			duplicates: []slicez.Duplicate{
+				{Value: "a", Indexes: []int{0, 2, 5}},
+				{Value: "b", Indexes: []int{1, 4}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(c.target, slicez.OfString.Unique)()
+			if c.duplicates == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var checkErr *check.Error
+			if assert.True(t, errors.As(err, &checkErr)) {
+				assert.Equal(t, slicez.ErrUnique, checkErr.Err)
+				assert.Equal(t, c.duplicates, checkErr.Params["duplicates"])
+			}
+		})
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	byX := slicez.UniqueBy[[]point](func(p point) int64 { return p.X })
+
+	assert.NoError(t, check.ThatOf([]point{{1, 1}, {2, 1}}, byX)())
+
+	err := check.ThatOf([]point{{1, 1}, {2, 1}, {1, 2}}, byX)()
+	var checkErr *check.Error
+	if assert.True(t, errors.As(err, &checkErr)) {
+		assert.Equal(t, slicez.CodeUnique, checkErr.Code)
+		assert.Equal(t, []slicez.Duplicate{{Value: int64(1), Indexes: []int{0, 2}}}, checkErr.Params["duplicates"])
+	}
+}
+
+func TestOrderedTyped(t *testing.T) {
+	ints := slicez.OrderedOf[[]int]()
+
+	cases := []struct {
+		name     string
+		target   []int
+		step     check.StepOf[[]int]
+		code     string
+		previous int
+		index    int
+	}{
+		{name: "empty ascending", step: ints.StrictlyAscending},
+		{name: "ascending", target: []int{1, 2, 2, 3}, step: ints.Ascending},
+		{name: "not ascending", target: []int{1, 3, 2, 4, 0}, step: ints.Ascending, code: slicez.CodeAscending, previous: 1, index: 2},
+		{name: "descending", target: []int{3, 3, 1}, step: ints.Descending},
+		{name: "not descending", target: []int{3, 4}, step: ints.Descending, code: slicez.CodeDescending, previous: 0, index: 1},
+		{name: "strictly ascending", target: []int{1, 2, 3}, step: ints.StrictlyAscending},
+		{name: "not strictly ascending", target: []int{1, 2, 2}, step: ints.StrictlyAscending, code: slicez.CodeStrictlyAscending, previous: 1, index: 2},
+		{name: "strictly descending", target: []int{3, 2, 1}, step: ints.StrictlyDescending},
+		{name: "not strictly descending", target: []int{3, 3}, step: ints.StrictlyDescending, code: slicez.CodeStrictlyDescending, previous: 0, index: 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if len(c.code) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var checkErr *check.Error
+			if assert.True(t, errors.As(err, &checkErr)) {
+				assert.Equal(t, slicez.ErrNotSorted, checkErr.Err)
+				assert.Equal(t, c.code, checkErr.Code)
+				assert.Equal(t, check.Params{"previous": c.previous, "index": c.index}, checkErr.Params)
+			}
+		})
+	}
+}
+
+func TestTyped_SortedBy(t *testing.T) {
+	byX := slicez.Of[[]point]().SortedBy(func(a, b point) bool { return a.X < b.X })
+
+	assert.NoError(t, check.ThatOf([]point{{1, 2}, {1, 1}, {2, 0}}, byX)())
+
+	err := check.ThatOf([]point{{1, 0}, {2, 0}, {0, 0}}, byX)()
+	var checkErr *check.Error
+	if assert.True(t, errors.As(err, &checkErr)) {
+		assert.Equal(t, slicez.CodeSortedBy, checkErr.Code)
+		assert.Equal(t, check.Params{"previous": 1, "index": 2}, checkErr.Params)
+	}
+}
+
+func TestStringTyped_Ascending(t *testing.T) {
+	assert.NoError(t, check.That([]string{"a", "b", "b"}, slicez.OfString.Ascending)())
+	assert.True(t, errors.Is(check.That([]string{"a", "b", "b"}, slicez.OfString.StrictlyAscending)(), slicez.ErrNotSorted))
+}
@@ -2,23 +2,19 @@ package slicez
 
 import (
 	"github.com/imulab/check"
-	"github.com/imulab/check/stringz"
+	"github.com/imulab/check/rangez"
 )
 
 // OfString is the entry point for all check.Step which assumes the target is a string slice.
 var OfString = stringTyped{
-	IsEmpty: check.Step(func(target interface{}) error {
-		if len(target.([]string)) == 0 {
-			return nil
-		}
-		return ErrIsNotEmpty
-	}),
-	IsNotEmpty: check.Step(func(target interface{}) error {
-		if len(target.([]string)) > 0 {
-			return nil
-		}
-		return ErrIsEmpty
-	}),
+	IsEmpty:    StringsOf[[]string]().IsEmpty.Step(),
+	IsNotEmpty: StringsOf[[]string]().IsNotEmpty.Step(),
+	Unique:     StringsOf[[]string]().Unique.Step(),
+
+	Ascending:          StringsOf[[]string]().Ascending.Step(),
+	Descending:         StringsOf[[]string]().Descending.Step(),
+	StrictlyAscending:  StringsOf[[]string]().StrictlyAscending.Step(),
+	StrictlyDescending: StringsOf[[]string]().StrictlyDescending.Step(),
 }
 
 type stringTyped struct {
@@ -28,75 +24,119 @@ type stringTyped struct {
 	// IsNotEmpty is a check.Step that verifies the target string slice
 	// is not empty, or returns ErrIsEmpty.
 	IsNotEmpty check.Step
+	// Unique is a check.Step that verifies the target string slice
+	// has no duplicate elements, or returns ErrUnique.
+	Unique check.Step
+	// Ascending is a check.Step that verifies the target string slice
+	// is sorted lexically, or returns ErrNotSorted.
+	Ascending check.Step
+	// Descending is a check.Step that verifies the target string slice
+	// is sorted lexically in reverse, or returns ErrNotSorted.
+	Descending check.Step
+	// StrictlyAscending is like Ascending, but also
+	// rejects equal adjacent elements.
+	StrictlyAscending check.Step
+	// StrictlyDescending is like Descending, but also
+	// rejects equal adjacent elements.
+	StrictlyDescending check.Step
 }
 
 // HasLength returns check.Step that verifies the given string slice has the expected length, or returns ErrHasLength.
 func (stringTyped) HasLength(length int) check.Step {
-	return func(target interface{}) error {
-		if len(target.([]string)) == length {
-			return nil
-		}
-		return ErrHasLength
-	}
+	return StringsOf[[]string]().HasLength(length).Step()
 }
 
 // HasLengthInRange returns check.Step that verifies the given string slice has the length in the
 // expected range, or returns HasLengthInRange.
 func (stringTyped) HasLengthInRange(startInclusive int, endExclusive int) check.Step {
-	return func(target interface{}) error {
-		length := len(target.([]string))
-		if startInclusive <= length && length < endExclusive {
-			return nil
-		}
-		return ErrHasLengthInRange
-	}
+	return StringsOf[[]string]().HasLengthInRange(startInclusive, endExclusive).Step()
+}
+
+// HasLengthWithin returns check.Step that verifies the length of the given string slice is in the rangez.Range, or
+// returns ErrHasLengthInRange.
+func (stringTyped) HasLengthWithin(r rangez.Range[int]) check.Step {
+	return StringsOf[[]string]().HasLengthWithin(r).Step()
 }
 
 // Contains returns check.Step that verifies the target string slice contains the expected element, or returns ErrContains.
-func (s stringTyped) Contains(value string) check.Step {
-	return s.Any(stringz.Is(value)).Err(ErrContains)
+func (stringTyped) Contains(value string) check.Step {
+	return StringsOf[[]string]().Contains(value).Step()
 }
 
 // NotContains returns check.Step that verifies the target string slice does not contain the element, or returns ErrNotContains.
-func (s stringTyped) NotContain(value string) check.Step {
-	return s.None(stringz.Is(value)).Err(ErrNotContain)
+func (stringTyped) NotContain(value string) check.Step {
+	return StringsOf[[]string]().NotContain(value).Step()
+}
+
+// SubsetOf returns check.Step that verifies every element of the target string slice is one of the values, or
+// returns ErrSubsetOf.
+func (stringTyped) SubsetOf(values ...string) check.Step {
+	return StringsOf[[]string]().SubsetOf(values...).Step()
+}
+
+// SupersetOf returns check.Step that verifies the target string slice contains every one of the values, or returns
+// ErrSupersetOf.
+func (stringTyped) SupersetOf(values ...string) check.Step {
+	return StringsOf[[]string]().SupersetOf(values...).Step()
+}
+
+// ContainsAll returns check.Step that verifies the target string slice contains all the values, or returns
+// ErrContainsAll.
+func (stringTyped) ContainsAll(values ...string) check.Step {
+	return StringsOf[[]string]().ContainsAll(values...).Step()
+}
+
+// ContainsAny returns check.Step that verifies the target string slice contains at least one of the values, or
+// returns ErrContainsAny.
+func (stringTyped) ContainsAny(values ...string) check.Step {
+	return StringsOf[[]string]().ContainsAny(values...).Step()
+}
+
+// Disjoint returns check.Step that verifies the target string slice contains none of the values, or returns
+// ErrDisjoint.
+func (stringTyped) Disjoint(values ...string) check.Step {
+	return StringsOf[[]string]().Disjoint(values...).Step()
 }
 
 // All checks all string slice elements conform to the condition of the element check.Step. If an element check.Step
-// returns an error, it is returned as the error. The element check.Step is NOT recommended to use check.Skip.
+// returns an error, it is returned as the error, annotated with the index of the element (see check.WithIndex).
+// The element check.Step is NOT recommended to use check.Skip.
 func (stringTyped) All(elemStep check.Step) check.Step {
-	return func(target interface{}) error {
-		for _, it := range target.([]string) {
-			if err := elemStep(it); err != nil {
-				return err
-			}
-		}
-		return nil
-	}
+	return StringsOf[[]string]().All(check.Typed[string](elemStep)).Step()
+}
+
+// AllParallel is like All, but checks the elements concurrently with the check.Parallel runner. See
+// StringsTyped.AllParallel.
+func (stringTyped) AllParallel(p check.Parallel, elemStep check.Step) check.Step {
+	return StringsOf[[]string]().AllParallel(p, check.Typed[string](elemStep)).Step()
 }
 
 // Any checks if any string slice elements conform to the condition of the element check.Step. If all element
 // check.Step returned error, ErrAny is returned.
 func (stringTyped) Any(elemStep check.Step) check.Step {
-	return func(target interface{}) error {
-		for _, it := range target.([]string) {
-			if err := elemStep(it); err == nil {
-				return nil
-			}
-		}
-		return ErrAny
-	}
+	return StringsOf[[]string]().Any(check.Typed[string](elemStep)).Step()
 }
 
 // None checks if none string slice elements conform to the condition of the element check.Step. If any element
 // check.Step returned nil, ErrNone is returned.
 func (stringTyped) None(elemStep check.Step) check.Step {
-	return func(target interface{}) error {
-		for _, it := range target.([]string) {
-			if err := elemStep(it); err == nil {
-				return ErrNone
-			}
-		}
-		return nil
-	}
+	return StringsOf[[]string]().None(check.Typed[string](elemStep)).Step()
+}
+
+// AtLeast checks at least n string slice elements conform to the condition of the element check.Step, or returns
+// ErrAtLeast.
+func (stringTyped) AtLeast(n int, elemStep check.Step) check.Step {
+	return StringsOf[[]string]().AtLeast(n, check.Typed[string](elemStep)).Step()
+}
+
+// AtMost checks at most n string slice elements conform to the condition of the element check.Step, or returns
+// ErrAtMost.
+func (stringTyped) AtMost(n int, elemStep check.Step) check.Step {
+	return StringsOf[[]string]().AtMost(n, check.Typed[string](elemStep)).Step()
+}
+
+// Exactly checks exactly n string slice elements conform to the condition of the element check.Step, or returns
+// ErrExactly.
+func (stringTyped) Exactly(n int, elemStep check.Step) check.Step {
+	return StringsOf[[]string]().Exactly(n, check.Typed[string](elemStep)).Step()
 }
@@ -1,6 +1,7 @@
 package slicez_test
 
 import (
+	"errors"
 	"github.com/imulab/check"
 	"github.com/imulab/check/slicez"
 	"github.com/imulab/check/stringz"
@@ -23,7 +24,7 @@ func TestStringTyped_HasLength(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.HasLength(c.length))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -50,7 +51,7 @@ func TestStringTyped_HasLengthInRange(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.HasLengthInRange(c.lower, c.upper))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -73,7 +74,7 @@ func TestStringTyped_Contains(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.Contains(c.seek))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -96,7 +97,7 @@ func TestStringTyped_NotContain(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.NotContain(c.seek))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -119,7 +120,7 @@ func TestStringTyped_All(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.All(c.elem))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -142,7 +143,7 @@ func TestStringTyped_Any(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.Any(c.elem))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -165,10 +166,152 @@ func TestStringTyped_None(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, slicez.OfString.None(c.elem))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
 		})
 	}
 }
+
+func TestStringTyped_All_Path(t *testing.T) {
+	err := check.That([]string{"1", "20"}, slicez.OfString.All(stringz.HasLength(1))).Path("user.tags")()
+
+	var pathErr *check.PathError
+	if assert.True(t, errors.As(err, &pathErr)) {
+		assert.Equal(t, "user.tags[1]", pathErr.Path)
+		assert.True(t, errors.Is(pathErr.Err, stringz.ErrHasLength))
+	}
+}
+
+func TestStringTyped_UnexpectedType(t *testing.T) {
+	err := check.That([]interface{}{"foo"}, slicez.OfString.All(stringz.IsNotEmpty))()
+	assert.True(t, errors.Is(err, check.ErrUnexpectedType))
+	assert.Equal(t, &check.TypeError{Expected: "[]string", Actual: "[]interface {}"}, err)
+}
+
+func TestStringTyped_AllParallel(t *testing.T) {
+	err := check.That([]string{"1", "20", "3", "40"},
+		slicez.OfString.AllParallel(check.Parallel{Workers: 2}, stringz.HasLength(1)),
+	)()
+	errs, ok := err.(check.Errors)
+	if assert.True(t, ok) && assert.Len(t, errs, 2) {
+		for i, path := range []string{"[1]", "[3]"} {
+			var pathErr *check.PathError
+			if assert.True(t, errors.As(errs[i], &pathErr)) {
+				assert.Equal(t, path, pathErr.Path)
+				assert.True(t, errors.Is(pathErr.Err, stringz.ErrHasLength))
+			}
+		}
+	}
+
+	assert.NoError(t, check.That([]string{"", "1"},
+		slicez.OfString.AllParallel(check.Parallel{}, check.Optional.When(stringz.IsEmpty)),
+	)())
+}
+
+func TestStringTyped_Contains_Error(t *testing.T) {
+	err := check.That([]string{"1", "2"}, slicez.OfString.Contains("3"))()
+	assert.Equal(t, &check.Error{
+		Code:   slicez.CodeContains,
+		Value:  []string{"1", "2"},
+		Params: check.Params{"value": "3"},
+		Err:    slicez.ErrContains,
+	}, err)
+}
+
+func TestStringTyped_Quantifiers(t *testing.T) {
+	target := []string{"1", "20", "3", "40"}
+
+	cases := []struct {
+		name  string
+		step  check.Step
+		err   error
+		count int
+	}{
+		{name: "at least", step: slicez.OfString.AtLeast(2, stringz.HasLength(1))},
+		{name: "not at least", step: slicez.OfString.AtLeast(3, stringz.HasLength(1)), err: slicez.ErrAtLeast, count: 2},
+		{name: "at most", step: slicez.OfString.AtMost(2, stringz.HasLength(1))},
+		{name: "not at most", step: slicez.OfString.AtMost(1, stringz.HasLength(1)), err: slicez.ErrAtMost, count: 2},
+		{name: "exactly", step: slicez.OfString.Exactly(2, stringz.HasLength(2))},
+		{name: "not exactly", step: slicez.OfString.Exactly(1, stringz.HasLength(2)), err: slicez.ErrExactly, count: 2},
+		{name: "exactly none", step: slicez.OfString.Exactly(0, stringz.HasLength(3))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var checkErr *check.Error
+			if assert.True(t, errors.As(err, &checkErr)) {
+				assert.Equal(t, c.err, checkErr.Err)
+				assert.Equal(t, c.count, checkErr.Params["count"])
+			}
+		})
+	}
+}
+
+func TestStringTyped_SetRelations(t *testing.T) {
+	target := []string{"read", "write", "read"}
+
+	cases := []struct {
+		name   string
+		step   check.Step
+		err    error
+		params check.Params
+	}{
+		{name: "subset", step: slicez.OfString.SubsetOf("read", "write", "admin")},
+		{
+			name:   "not subset",
+			step:   slicez.OfString.SubsetOf("read"),
+			err:    slicez.ErrSubsetOf,
+			params: check.Params{"values": []string{"read"}, "unexpected": []string{"write"}},
+		},
+		{name: "superset", step: slicez.OfString.SupersetOf("write", "read")},
+		{
+			name:   "not superset",
+			step:   slicez.OfString.SupersetOf("read", "admin", "owner", "admin"),
+			err:    slicez.ErrSupersetOf,
+			params: check.Params{"values": []string{"read", "admin", "owner", "admin"}, "missing": []string{"admin", "owner"}},
+		},
+		{name: "contains all", step: slicez.OfString.ContainsAll("read")},
+		{
+			name:   "not contains all",
+			step:   slicez.OfString.ContainsAll("read", "admin"),
+			err:    slicez.ErrContainsAll,
+			params: check.Params{"values": []string{"read", "admin"}, "missing": []string{"admin"}},
+		},
+		{name: "contains any", step: slicez.OfString.ContainsAny("admin", "write")},
+		{
+			name:   "not contains any",
+			step:   slicez.OfString.ContainsAny("admin"),
+			err:    slicez.ErrContainsAny,
+			params: check.Params{"values": []string{"admin"}},
+		},
+		{name: "disjoint", step: slicez.OfString.Disjoint("admin")},
+		{
+			name:   "not disjoint",
+			step:   slicez.OfString.Disjoint("admin", "read"),
+			err:    slicez.ErrDisjoint,
+			params: check.Params{"values": []string{"admin", "read"}, "overlap": []string{"read"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var checkErr *check.Error
+			if assert.True(t, errors.As(err, &checkErr)) {
+				assert.Equal(t, c.err, checkErr.Err)
+				assert.Equal(t, c.params, checkErr.Params)
+			}
+		})
+	}
+}
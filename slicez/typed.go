@@ -0,0 +1,16 @@
+package slicez
+
+// StringsOf returns the namespace for all check.StepOf which assumes the target is of string slice type S, whose
+// elements are of string type E. E is inferred from S.
+//
+//	type Email string
+//	check.ThatOf(emails, slicez.StringsOf[[]Email]().All(stringz.Of[Email]().Contains("@")))
+func StringsOf[S ~[]E, E ~string]() StringsTyped[S, E] {
+	return StringsTyped[S, E]{OrderedTyped: OrderedOf[S]()}
+}
+
+// StringsTyped is the namespace for check.StepOf with respect to string slice type S. Use StringsOf to obtain
+// an instance.
+type StringsTyped[S ~[]E, E ~string] struct {
+	OrderedTyped[S, E]
+}
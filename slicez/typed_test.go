@@ -0,0 +1,39 @@
+package slicez_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/slicez"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type email string
+
+func TestStringsOf(t *testing.T) {
+	cases := []struct {
+		name   string
+		target []email
+		step   check.StepOf[[]email]
+		err    error
+	}{
+		{name: "not empty", target: []email{"foo@bar.com"}, step: slicez.StringsOf[[]email]().IsNotEmpty},
+		{name: "empty", target: []email{}, step: slicez.StringsOf[[]email]().IsNotEmpty, err: slicez.ErrIsEmpty},
+		{name: "contains", target: []email{"foo@bar.com"}, step: slicez.StringsOf[[]email]().Contains("foo@bar.com")},
+		{name: "does not contain", target: []email{"foo@bar.com"}, step: slicez.StringsOf[[]email]().Contains("bar@foo.com"), err: slicez.ErrContains},
+		{name: "all", target: []email{"foo@bar.com"}, step: slicez.StringsOf[[]email]().All(stringz.Of[email]().Contains("@"))},
+		{name: "not all", target: []email{"foo@bar.com", "foo"}, step: slicez.StringsOf[[]email]().All(stringz.Of[email]().Contains("@")), err: stringz.ErrContains},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if c.err != nil {
+				assert.True(t, errors.Is(err, c.err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
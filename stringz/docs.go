@@ -1,2 +1,5 @@
 // Package stringz contains check.Step implementation related to string.
+//
+// Errors are returned as *check.Error, which keeps the code, the offending value and the rule parameters, and
+// matches the documented sentinel error with errors.Is.
 package stringz
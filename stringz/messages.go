@@ -0,0 +1,24 @@
+package stringz
+
+import "github.com/imulab/check/catalog"
+
+// Messages contains the English message templates of the codes in this package, keyed by code. They are
+// registered into catalog on init, so that any catalog.Renderer renders string errors out of the box.
+var Messages = map[string]string{
+	CodeIs:               "must be {expected}",
+	CodeIsNot:            "must not be {unexpected}",
+	CodeIsEmpty:          "must be empty",
+	CodeIsNotEmpty:       "must not be empty",
+	CodeIn:               "must be one of {values}",
+	CodeHasLength:        "must have length {length}",
+	CodeHasLengthInRange: "must have length at least {start} and less than {end}",
+	CodeHasPrefix:        "must start with {prefix}",
+	CodeHasSuffix:        "must end with {suffix}",
+	CodeContains:         "must contain {substring}",
+	CodeMatches:          "must match pattern {pattern}",
+	CodeHasLengthWithin:  "must have a length within {range}",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
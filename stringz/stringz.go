@@ -3,13 +3,13 @@ package stringz
 import (
 	"errors"
 	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
 	"regexp"
-	"strings"
 )
 
 var (
-	ErrIs               = errors.New("unexpected string error")
-	ErrIsNot            = errors.New("unexpected string error")
+	ErrIs               = errors.New("string does not equal expected value")
+	ErrIsNot            = errors.New("string equals unexpected value")
 	ErrIsEmpty          = errors.New("string is not empty")
 	ErrIsNotEmpty       = errors.New("string is empty")
 	ErrIn               = errors.New("string value not among expected values")
@@ -21,114 +21,80 @@ var (
 	ErrMatches          = errors.New("string does not match expected pattern")
 )
 
+// Codes of the check.Error returned by the check.Step in this package.
+const (
+	CodeIs               = "string.is"
+	CodeIsNot            = "string.is_not"
+	CodeIsEmpty          = "string.is_empty"
+	CodeIsNotEmpty       = "string.is_not_empty"
+	CodeIn               = "string.in"
+	CodeHasLength        = "string.has_length"
+	CodeHasLengthInRange = "string.has_length_in_range"
+	CodeHasPrefix        = "string.has_prefix"
+	CodeHasSuffix        = "string.has_suffix"
+	CodeContains         = "string.contains"
+	CodeMatches          = "string.matches"
+	CodeHasLengthWithin  = "string.has_length_within"
+)
+
 // Is returns check.Step to verify target string has the expected value, or return ErrIs.
 func Is(expect string) check.Step {
-	return func(target interface{}) error {
-		if target.(string) == expect {
-			return nil
-		}
-		return ErrIs
-	}
+	return Of[string]().Is(expect).Step()
 }
 
 // IsNot returns check.Step to verify target string is not the unexpected value, or return ErrIsNot.
 func IsNot(unexpected string) check.Step {
-	return func(target interface{}) error {
-		if target.(string) != unexpected {
-			return nil
-		}
-		return ErrIsNot
-	}
+	return Of[string]().IsNot(unexpected).Step()
 }
 
 // IsEmpty is a check.Step that verifies the given string is empty (zero length), or returns ErrIsEmpty.
-var IsEmpty check.Step = func(target interface{}) error {
-	if len(target.(string)) == 0 {
-		return nil
-	}
-	return ErrIsEmpty
-}
+var IsEmpty = Of[string]().IsEmpty.Step()
 
 // IsNotEmpty is a check.Step that verifies the given string is not empty, or returns ErrIsNotEmpty.
-var IsNotEmpty check.Step = func(target interface{}) error {
-	if len(target.(string)) > 0 {
-		return nil
-	}
-	return ErrIsNotEmpty
-}
+var IsNotEmpty = Of[string]().IsNotEmpty.Step()
 
 // In returns a check.Step that verifies the target string value is among the expected list of values,
 // or returns ErrIn.
 func In(values ...string) check.Step {
-	return func(target interface{}) error {
-		for _, it := range values {
-			if it == target.(string) {
-				return nil
-			}
-		}
-		return ErrIn
-	}
+	return Of[string]().In(values...).Step()
 }
 
 // HasLength returns check.Step that verifies the given string has the expected length, or returns ErrHasLength.
 func HasLength(length int) check.Step {
-	return func(target interface{}) error {
-		if len(target.(string)) == length {
-			return nil
-		}
-		return ErrHasLength
-	}
+	return Of[string]().HasLength(length).Step()
 }
 
 // HasLengthInRange returns check.Step that verifies the given string has the length in the
 // expected range, or returns HasLengthInRange.
 func HasLengthInRange(startInclusive int, endExclusive int) check.Step {
-	return func(target interface{}) error {
-		length := len(target.(string))
-		if startInclusive <= length && length < endExclusive {
-			return nil
-		}
-		return ErrHasLengthInRange
-	}
+	return Of[string]().HasLengthInRange(startInclusive, endExclusive).Step()
+}
+
+// HasLengthWithin returns check.Step that verifies the length of the given string is in the rangez.Range, or returns
+// ErrHasLengthInRange. Note the length is the number of bytes, like HasLength.
+//
+//	stringz.HasLengthWithin(rangez.Closed(1, 64))
+func HasLengthWithin(r rangez.Range[int]) check.Step {
+	return Of[string]().HasLengthWithin(r).Step()
 }
 
 // HasPrefix returns check.Step that verifies the target string has the expected prefix, or returns ErrHasPrefix.
 func HasPrefix(prefix string) check.Step {
-	return func(target interface{}) error {
-		if strings.HasPrefix(target.(string), prefix) {
-			return nil
-		}
-		return ErrHasPrefix
-	}
+	return Of[string]().HasPrefix(prefix).Step()
 }
 
 // HasSuffix returns check.Step that verifies the target string has the expected suffix, or returns ErrHasSuffix.
 func HasSuffix(suffix string) check.Step {
-	return func(target interface{}) error {
-		if strings.HasSuffix(target.(string), suffix) {
-			return nil
-		}
-		return ErrHasSuffix
-	}
+	return Of[string]().HasSuffix(suffix).Step()
 }
 
 // Contains returns check.Step that verifies the target string contains the expected substring, or returns ErrContains.
 func Contains(substring string) check.Step {
-	return func(target interface{}) error {
-		if strings.Contains(target.(string), substring) {
-			return nil
-		}
-		return ErrContains
-	}
+	return Of[string]().Contains(substring).Step()
 }
 
 // Matches returns check.Step that verifies the target string matches the given regular expression pattern, or
 // returns ErrMatches.
 func Matches(pattern *regexp.Regexp) check.Step {
-	return func(target interface{}) error {
-		if pattern.MatchString(target.(string)) {
-			return nil
-		}
-		return ErrMatches
-	}
+	return Of[string]().Matches(pattern).Step()
 }
@@ -1,6 +1,7 @@
 package stringz_test
 
 import (
+	"errors"
 	"github.com/imulab/check"
 	"github.com/imulab/check/stringz"
 	"github.com/stretchr/testify/assert"
@@ -23,7 +24,7 @@ func TestIs(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.Is(c.expected))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -46,7 +47,7 @@ func TestIsNot(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.IsNot(c.unexpected))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -68,7 +69,7 @@ func TestIsEmpty(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.IsEmpty)()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -90,7 +91,7 @@ func TestIsNotEmpty(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.IsNotEmpty)()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -113,7 +114,7 @@ func TestIn(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.In(c.in...))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -136,7 +137,7 @@ func TestHasLength(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.HasLength(c.length))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -163,7 +164,7 @@ func TestHasLengthInRange(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.HasLengthInRange(c.lower, c.upper))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -186,7 +187,7 @@ func TestHasPrefix(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.HasPrefix(c.prefix))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -209,7 +210,7 @@ func TestHasSuffix(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.HasSuffix(c.suffix))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -232,7 +233,7 @@ func TestContains(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.Contains(c.substr))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -255,10 +256,26 @@ func TestMatches(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			err := check.That(c.target, stringz.Matches(regexp.MustCompile(c.pattern)))()
 			if c.err != nil {
-				assert.Equal(t, c.err, err)
+				assert.True(t, errors.Is(err, c.err))
 			} else {
 				assert.NoError(t, err)
 			}
 		})
 	}
 }
+
+func TestUnexpectedType(t *testing.T) {
+	err := check.That(email("foo"), stringz.IsNotEmpty)()
+	assert.True(t, errors.Is(err, check.ErrUnexpectedType))
+	assert.Equal(t, &check.TypeError{Expected: "string", Actual: "stringz_test.email"}, err)
+}
+
+func TestIn_Error(t *testing.T) {
+	err := check.That("foo", stringz.In("bar", "baz"))()
+	assert.Equal(t, &check.Error{
+		Code:   stringz.CodeIn,
+		Value:  "foo",
+		Params: check.Params{"values": []string{"bar", "baz"}},
+		Err:    stringz.ErrIn,
+	}, err)
+}
@@ -0,0 +1,149 @@
+package stringz
+
+import (
+	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+	"regexp"
+	"strings"
+)
+
+// Of returns the namespace for all check.StepOf which assumes the target is of string type S, including named
+// string types.
+//
+//	type Email string
+//	check.ThatOf(email, stringz.Of[Email]().IsNotEmpty, stringz.Of[Email]().Contains("@"))
+func Of[S ~string]() Typed[S] {
+	return Typed[S]{
+		IsEmpty: func(target S) error {
+			if len(target) == 0 {
+				return nil
+			}
+			return check.NewError(ErrIsEmpty, CodeIsEmpty, target, nil)
+		},
+		IsNotEmpty: func(target S) error {
+			if len(target) > 0 {
+				return nil
+			}
+			return check.NewError(ErrIsNotEmpty, CodeIsNotEmpty, target, nil)
+		},
+	}
+}
+
+// Typed is the namespace for check.StepOf with respect to string type S. Use Of to obtain an instance.
+type Typed[S ~string] struct {
+	// IsEmpty is a check.StepOf that verifies the given string is empty (zero length), or returns ErrIsEmpty.
+	IsEmpty check.StepOf[S]
+	// IsNotEmpty is a check.StepOf that verifies the given string is not empty, or returns ErrIsNotEmpty.
+	IsNotEmpty check.StepOf[S]
+}
+
+// Is returns check.StepOf to verify target string has the expected value, or return ErrIs.
+func (Typed[S]) Is(expect S) check.StepOf[S] {
+	return func(target S) error {
+		if target == expect {
+			return nil
+		}
+		return check.NewError(ErrIs, CodeIs, target, check.Params{"expected": expect})
+	}
+}
+
+// IsNot returns check.StepOf to verify target string is not the unexpected value, or return ErrIsNot.
+func (Typed[S]) IsNot(unexpected S) check.StepOf[S] {
+	return func(target S) error {
+		if target != unexpected {
+			return nil
+		}
+		return check.NewError(ErrIsNot, CodeIsNot, target, check.Params{"unexpected": unexpected})
+	}
+}
+
+// In returns a check.StepOf that verifies the target string value is among the expected list of values,
+// or returns ErrIn.
+func (Typed[S]) In(values ...S) check.StepOf[S] {
+	return func(target S) error {
+		for _, it := range values {
+			if it == target {
+				return nil
+			}
+		}
+		return check.NewError(ErrIn, CodeIn, target, check.Params{"values": values})
+	}
+}
+
+// HasLength returns check.StepOf that verifies the given string has the expected length, or returns ErrHasLength.
+func (Typed[S]) HasLength(length int) check.StepOf[S] {
+	return func(target S) error {
+		if len(target) == length {
+			return nil
+		}
+		return check.NewError(ErrHasLength, CodeHasLength, target, check.Params{"length": length})
+	}
+}
+
+// HasLengthInRange returns check.StepOf that verifies the given string has the length in the
+// expected range, or returns HasLengthInRange.
+func (Typed[S]) HasLengthInRange(startInclusive int, endExclusive int) check.StepOf[S] {
+	return func(target S) error {
+		length := len(target)
+		if startInclusive <= length && length < endExclusive {
+			return nil
+		}
+		return check.NewError(ErrHasLengthInRange, CodeHasLengthInRange, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// HasLengthWithin returns check.StepOf that verifies the length of the target string is in the rangez.Range, or
+// returns ErrHasLengthInRange with the "range" parameter.
+func (Typed[S]) HasLengthWithin(r rangez.Range[int]) check.StepOf[S] {
+	return func(target S) error {
+		if r.Contains(len(target)) {
+			return nil
+		}
+		return check.NewError(ErrHasLengthInRange, CodeHasLengthWithin, target, check.Params{"range": r})
+	}
+}
+
+// HasPrefix returns check.StepOf that verifies the target string has the expected prefix, or returns ErrHasPrefix.
+func (Typed[S]) HasPrefix(prefix string) check.StepOf[S] {
+	return func(target S) error {
+		if strings.HasPrefix(string(target), prefix) {
+			return nil
+		}
+		return check.NewError(ErrHasPrefix, CodeHasPrefix, target, check.Params{"prefix": prefix})
+	}
+}
+
+// HasSuffix returns check.StepOf that verifies the target string has the expected suffix, or returns ErrHasSuffix.
+func (Typed[S]) HasSuffix(suffix string) check.StepOf[S] {
+	return func(target S) error {
+		if strings.HasSuffix(string(target), suffix) {
+			return nil
+		}
+		return check.NewError(ErrHasSuffix, CodeHasSuffix, target, check.Params{"suffix": suffix})
+	}
+}
+
+// Contains returns check.StepOf that verifies the target string contains the expected substring, or returns
+// ErrContains.
+func (Typed[S]) Contains(substring string) check.StepOf[S] {
+	return func(target S) error {
+		if strings.Contains(string(target), substring) {
+			return nil
+		}
+		return check.NewError(ErrContains, CodeContains, target, check.Params{"substring": substring})
+	}
+}
+
+// Matches returns check.StepOf that verifies the target string matches the given regular expression pattern, or
+// returns ErrMatches.
+func (Typed[S]) Matches(pattern *regexp.Regexp) check.StepOf[S] {
+	return func(target S) error {
+		if pattern.MatchString(string(target)) {
+			return nil
+		}
+		return check.NewError(ErrMatches, CodeMatches, target, check.Params{"pattern": pattern.String()})
+	}
+}
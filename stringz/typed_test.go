@@ -0,0 +1,41 @@
+package stringz_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/rangez"
+	"github.com/imulab/check/stringz"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type email string
+
+func TestOf(t *testing.T) {
+	cases := []struct {
+		name   string
+		target email
+		steps  []check.StepOf[email]
+		err    error
+	}{
+		{name: "not empty", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().IsNotEmpty}},
+		{name: "empty", target: "", steps: []check.StepOf[email]{stringz.Of[email]().IsNotEmpty}, err: stringz.ErrIsNotEmpty},
+		{name: "in", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().In("foo@bar.com")}},
+		{name: "not in", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().In("bar@foo.com")}, err: stringz.ErrIn},
+		{name: "has length within", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().HasLengthWithin(rangez.Closed(3, 11))}},
+		{name: "not has length within", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().HasLengthWithin(rangez.Open(3, 11))}, err: stringz.ErrHasLengthInRange},
+		{name: "contains", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().Contains("@")}},
+		{name: "has suffix", target: "foo@bar.com", steps: []check.StepOf[email]{stringz.Of[email]().HasSuffix(".org")}, err: stringz.ErrHasSuffix},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.steps...)()
+			if c.err != nil {
+				assert.True(t, errors.Is(err, c.err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
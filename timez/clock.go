@@ -0,0 +1,80 @@
+package timez
+
+import (
+	"github.com/imulab/check"
+	"time"
+)
+
+// Clock tells the current time to the checks relative to now.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is a function adapter of Clock.
+type ClockFunc func() time.Time
+
+// Now calls the function.
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// System is the Clock of the operating system, that is, time.Now.
+var System Clock = ClockFunc(time.Now)
+
+// Fixed returns a Clock which always tells the time t.
+func Fixed(t time.Time) Clock {
+	return ClockFunc(func() time.Time {
+		return t
+	})
+}
+
+// InFuture is a check.Step that verifies the target time is after the current time of the System clock, or
+// returns ErrInFuture. For another Clock, use On.
+var InFuture = On(System).InFuture.Step()
+
+// InPast is a check.Step that verifies the target time is before the current time of the System clock, or returns
+// ErrInPast. For another Clock, use On.
+var InPast = On(System).InPast.Step()
+
+// On returns the namespace for all check.StepOf relative to the current time of the clock.
+//
+//	clock := timez.Fixed(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+//	check.ThatOf(expiry, timez.On(clock).InFuture)
+func On(clock Clock) Clocked {
+	return Clocked{
+		clock: clock,
+		InFuture: func(target time.Time) error {
+			if now := clock.Now(); !target.After(now) {
+				return check.NewError(ErrInFuture, CodeInFuture, target, check.Params{"now": now})
+			}
+			return nil
+		},
+		InPast: func(target time.Time) error {
+			if now := clock.Now(); !target.Before(now) {
+				return check.NewError(ErrInPast, CodeInPast, target, check.Params{"now": now})
+			}
+			return nil
+		},
+	}
+}
+
+// Clocked is the namespace for check.StepOf relative to the current time of a Clock. Use On to obtain an instance.
+type Clocked struct {
+	clock Clock
+	// InFuture is a check.StepOf that verifies the target time
+	// is after the current time, or returns ErrInFuture.
+	InFuture check.StepOf[time.Time]
+	// InPast is a check.StepOf that verifies the target time
+	// is before the current time, or returns ErrInPast.
+	InPast check.StepOf[time.Time]
+}
+
+// Within returns a check.StepOf that verifies the target time is at most d away from the current time in either
+// direction, or returns ErrWithin.
+//
+//	timez.On(timez.System).Within(5 * time.Minute)	// i.e. a timestamp of a signed request
+func (c Clocked) Within(d time.Duration) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		return OfTime.Within(c.clock.Now(), d)(target)
+	}
+}
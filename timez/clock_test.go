@@ -0,0 +1,46 @@
+package timez_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/timez"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOn(t *testing.T) {
+	clock := timez.On(timez.Fixed(monday))
+
+	cases := []struct {
+		name   string
+		target time.Time
+		step   check.StepOf[time.Time]
+		err    error
+	}{
+		{name: "in future", target: monday.Add(time.Second), step: clock.InFuture},
+		{name: "now not in future", target: monday, step: clock.InFuture, err: timez.ErrInFuture},
+		{name: "in past", target: monday.Add(-time.Second), step: clock.InPast},
+		{name: "now not in past", target: monday, step: clock.InPast, err: timez.ErrInPast},
+		{name: "within", target: monday.Add(-5 * time.Minute), step: clock.Within(5 * time.Minute)},
+		{name: "not within", target: monday.Add(6 * time.Minute), step: clock.Within(5 * time.Minute), err: timez.ErrWithin},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.ThatOf(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestSystem(t *testing.T) {
+	now := time.Now()
+	assert.NoError(t, check.That(now.Add(time.Hour), timez.InFuture)())
+	assert.NoError(t, check.That(now.Add(-time.Hour), timez.InPast)())
+	assert.True(t, errors.Is(check.That(now.Add(-time.Hour), timez.InFuture)(), timez.ErrInFuture))
+}
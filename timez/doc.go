@@ -0,0 +1,17 @@
+// Package timez contains check.Step implementation related to time.Time and time.Duration.
+//
+//	check.That(start,
+//		timez.NotZero,
+//		timez.InFuture,
+//		timez.BusinessHours(9*time.Hour, 17*time.Hour),
+//	)
+//	check.That(timeout, timez.DurationBetween(time.Second, time.Minute))
+//
+// Checks relative to the current time ask a Clock for it. InFuture and InPast use the System clock. For deterministic
+// tests, bind the checks to another Clock with On, and have the code under test take the Clock as a dependency:
+//
+//	timez.On(timez.Fixed(now)).InFuture
+//
+// Calendar checks, i.e. Weekday and SameDay, are evaluated in the location of the target time. Typed check.StepOf
+// are available via the OfTime and OfDuration namespaces.
+package timez
@@ -0,0 +1,45 @@
+package timez
+
+import (
+	"github.com/imulab/check"
+	"time"
+)
+
+// OfDuration is the namespace for all check.StepOf which assumes the target is a time.Duration.
+var OfDuration = DurationTyped{}
+
+// DurationTyped is the namespace for check.StepOf with respect to time.Duration. Use OfDuration to access it.
+type DurationTyped struct{}
+
+// AtLeast returns a check.StepOf that verifies the target duration is no shorter than min, or returns
+// ErrDurationAtLeast.
+func (DurationTyped) AtLeast(min time.Duration) check.StepOf[time.Duration] {
+	return func(target time.Duration) error {
+		if target >= min {
+			return nil
+		}
+		return check.NewError(ErrDurationAtLeast, CodeDurationAtLeast, target, check.Params{"bound": min})
+	}
+}
+
+// AtMost returns a check.StepOf that verifies the target duration is no longer than max, or returns
+// ErrDurationAtMost.
+func (DurationTyped) AtMost(max time.Duration) check.StepOf[time.Duration] {
+	return func(target time.Duration) error {
+		if target <= max {
+			return nil
+		}
+		return check.NewError(ErrDurationAtMost, CodeDurationAtMost, target, check.Params{"bound": max})
+	}
+}
+
+// Between returns a check.StepOf that verifies the target duration is from min to max, both inclusive, or returns
+// ErrDurationBetween.
+func (DurationTyped) Between(min time.Duration, max time.Duration) check.StepOf[time.Duration] {
+	return func(target time.Duration) error {
+		if min <= target && target <= max {
+			return nil
+		}
+		return check.NewError(ErrDurationBetween, CodeDurationBetween, target, check.Params{"min": min, "max": max})
+	}
+}
@@ -0,0 +1,36 @@
+package timez
+
+import "errors"
+
+var (
+	ErrBefore          = errors.New("time is not before expected time")
+	ErrAfter           = errors.New("time is not after expected time")
+	ErrBetween         = errors.New("time is not in expected period")
+	ErrNotZero         = errors.New("time is zero")
+	ErrInFuture        = errors.New("time is not in the future")
+	ErrInPast          = errors.New("time is not in the past")
+	ErrWithin          = errors.New("time is not within expected duration of reference time")
+	ErrSameDay         = errors.New("time is not on the same day as reference time")
+	ErrWeekday         = errors.New("time is not on expected day of week")
+	ErrTimeOfDay       = errors.New("time is not within expected hours")
+	ErrDurationAtLeast = errors.New("duration is shorter than expected")
+	ErrDurationAtMost  = errors.New("duration is longer than expected")
+	ErrDurationBetween = errors.New("duration is not in expected range")
+)
+
+// Codes of the check.Error returned by the check.Step in this package.
+const (
+	CodeBefore          = "time.before"
+	CodeAfter           = "time.after"
+	CodeBetween         = "time.between"
+	CodeNotZero         = "time.not_zero"
+	CodeInFuture        = "time.in_future"
+	CodeInPast          = "time.in_past"
+	CodeWithin          = "time.within"
+	CodeSameDay         = "time.same_day"
+	CodeWeekday         = "time.weekday"
+	CodeTimeOfDay       = "time.time_of_day"
+	CodeDurationAtLeast = "duration.at_least"
+	CodeDurationAtMost  = "duration.at_most"
+	CodeDurationBetween = "duration.between"
+)
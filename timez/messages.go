@@ -0,0 +1,25 @@
+package timez
+
+import "github.com/imulab/check/catalog"
+
+// Messages maps the time and duration codes to their English message templates, which the package registers
+// into catalog on init.
+var Messages = map[string]string{
+	CodeBefore:          "must be before {bound}",
+	CodeAfter:           "must be after {bound}",
+	CodeBetween:         "must be at or after {start} and before {end}",
+	CodeNotZero:         "must not be empty",
+	CodeInFuture:        "must be in the future",
+	CodeInPast:          "must be in the past",
+	CodeWithin:          "must be within {duration} of {reference}",
+	CodeSameDay:         "must be on the same day as {reference}",
+	CodeWeekday:         "must be on {weekdays}",
+	CodeTimeOfDay:       "must be between {start} and {end} after midnight",
+	CodeDurationAtLeast: "must be at least {bound}",
+	CodeDurationAtMost:  "must be at most {bound}",
+	CodeDurationBetween: "must be between {min} and {max}",
+}
+
+func init() {
+	catalog.Register("en", Messages)
+}
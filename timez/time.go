@@ -0,0 +1,131 @@
+package timez
+
+import (
+	"github.com/imulab/check"
+	"time"
+)
+
+// OfTime is the namespace for all check.StepOf which assumes the target is a time.Time.
+var OfTime = TimeTyped{
+	NotZero: func(target time.Time) error {
+		if target.IsZero() {
+			return check.NewError(ErrNotZero, CodeNotZero, target, nil)
+		}
+		return nil
+	},
+}
+
+// TimeTyped is the namespace for check.StepOf with respect to time.Time. Use OfTime to access it.
+type TimeTyped struct {
+	// NotZero is a check.StepOf that verifies the target time
+	// is not the zero time, or returns ErrNotZero.
+	NotZero check.StepOf[time.Time]
+}
+
+// Before returns a check.StepOf that verifies the target time is strictly before the bound, or returns ErrBefore.
+func (TimeTyped) Before(bound time.Time) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		if target.Before(bound) {
+			return nil
+		}
+		return check.NewError(ErrBefore, CodeBefore, target, check.Params{"bound": bound})
+	}
+}
+
+// After returns a check.StepOf that verifies the target time is strictly after the bound, or returns ErrAfter.
+func (TimeTyped) After(bound time.Time) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		if target.After(bound) {
+			return nil
+		}
+		return check.NewError(ErrAfter, CodeAfter, target, check.Params{"bound": bound})
+	}
+}
+
+// Between returns a check.StepOf that verifies the target time is in the period from the inclusive start to the
+// exclusive end, or returns ErrBetween.
+func (TimeTyped) Between(startInclusive time.Time, endExclusive time.Time) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		if !target.Before(startInclusive) && target.Before(endExclusive) {
+			return nil
+		}
+		return check.NewError(ErrBetween, CodeBetween, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// Within returns a check.StepOf that verifies the target time is at most d away from the reference time in either
+// direction, or returns ErrWithin with the "reference" and "duration" parameters.
+func (TimeTyped) Within(reference time.Time, d time.Duration) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		if diff := target.Sub(reference); -d <= diff && diff <= d {
+			return nil
+		}
+		return check.NewError(ErrWithin, CodeWithin, target, check.Params{"reference": reference, "duration": d})
+	}
+}
+
+// SameDay returns a check.StepOf that verifies the target time falls on the same calendar date as the reference
+// time, when both are seen in the location of the target, or returns ErrSameDay.
+func (TimeTyped) SameDay(reference time.Time) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		y1, m1, d1 := target.Date()
+		y2, m2, d2 := reference.In(target.Location()).Date()
+		if y1 == y2 && m1 == m2 && d1 == d2 {
+			return nil
+		}
+		return check.NewError(ErrSameDay, CodeSameDay, target, check.Params{"reference": reference})
+	}
+}
+
+// Weekday returns a check.StepOf that verifies the target time falls on one of the days of week, or returns
+// ErrWeekday with the "weekdays" parameter.
+func (TimeTyped) Weekday(weekdays ...time.Weekday) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		for _, it := range weekdays {
+			if target.Weekday() == it {
+				return nil
+			}
+		}
+		return check.NewError(ErrWeekday, CodeWeekday, target, check.Params{"weekdays": weekdays})
+	}
+}
+
+// TimeOfDay returns a check.StepOf that verifies the wall clock time of the target is from the inclusive start
+// to the exclusive end, both given as the duration since midnight, or returns ErrTimeOfDay. If start is after end,
+// the period spans midnight, i.e. a night shift from 22 to 6 o'clock.
+//
+//	timez.OfTime.TimeOfDay(9*time.Hour, 17*time.Hour+30*time.Minute)
+func (TimeTyped) TimeOfDay(startInclusive time.Duration, endExclusive time.Duration) check.StepOf[time.Time] {
+	return func(target time.Time) error {
+		h, m, s := target.Clock()
+		clock := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second +
+			time.Duration(target.Nanosecond())
+
+		var ok bool
+		if startInclusive <= endExclusive {
+			ok = startInclusive <= clock && clock < endExclusive
+		} else {
+			ok = startInclusive <= clock || clock < endExclusive
+		}
+		if ok {
+			return nil
+		}
+		return check.NewError(ErrTimeOfDay, CodeTimeOfDay, target, check.Params{
+			"start": startInclusive,
+			"end":   endExclusive,
+		})
+	}
+}
+
+// BusinessHours returns a check.StepOf that verifies the target time is from Monday to Friday, and within the
+// hours of TimeOfDay. It returns the error of Weekday or TimeOfDay, whichever fails first.
+func (t TimeTyped) BusinessHours(startInclusive time.Duration, endExclusive time.Duration) check.StepOf[time.Time] {
+	weekday := t.Weekday(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+	timeOfDay := t.TimeOfDay(startInclusive, endExclusive)
+	return func(target time.Time) error {
+		return check.ThatOf(target, weekday, timeOfDay)()
+	}
+}
@@ -0,0 +1,75 @@
+package timez
+
+import (
+	"github.com/imulab/check"
+	"time"
+)
+
+// NotZero is a check.Step that verifies the target time is not the zero time, or returns ErrNotZero.
+var NotZero = OfTime.NotZero.Step()
+
+// Before returns a check.Step that verifies the target time is strictly before the bound, or returns ErrBefore.
+func Before(bound time.Time) check.Step {
+	return OfTime.Before(bound).Step()
+}
+
+// After returns a check.Step that verifies the target time is strictly after the bound, or returns ErrAfter.
+func After(bound time.Time) check.Step {
+	return OfTime.After(bound).Step()
+}
+
+// Between returns a check.Step that verifies the target time is from the inclusive start to the exclusive end, or
+// returns ErrBetween.
+func Between(startInclusive time.Time, endExclusive time.Time) check.Step {
+	return OfTime.Between(startInclusive, endExclusive).Step()
+}
+
+// Within returns a check.Step that verifies the target time is at most d away from the reference time, or returns
+// ErrWithin.
+func Within(reference time.Time, d time.Duration) check.Step {
+	return OfTime.Within(reference, d).Step()
+}
+
+// SameDay returns a check.Step that verifies the target time is on the same date as the reference time, or returns
+// ErrSameDay.
+func SameDay(reference time.Time) check.Step {
+	return OfTime.SameDay(reference).Step()
+}
+
+// Weekday returns a check.Step that verifies the target time falls on one of the days of week, or returns
+// ErrWeekday.
+func Weekday(weekdays ...time.Weekday) check.Step {
+	return OfTime.Weekday(weekdays...).Step()
+}
+
+// TimeOfDay returns a check.Step that verifies the wall clock time of the target is within the hours given as the
+// duration since midnight, or returns ErrTimeOfDay.
+func TimeOfDay(startInclusive time.Duration, endExclusive time.Duration) check.Step {
+	return OfTime.TimeOfDay(startInclusive, endExclusive).Step()
+}
+
+// BusinessHours returns a check.Step that verifies the target time is on a weekday and within the hours, or returns
+// ErrWeekday or ErrTimeOfDay.
+//
+//	timez.BusinessHours(9*time.Hour, 17*time.Hour)
+func BusinessHours(startInclusive time.Duration, endExclusive time.Duration) check.Step {
+	return OfTime.BusinessHours(startInclusive, endExclusive).Step()
+}
+
+// DurationAtLeast returns a check.Step that verifies the target duration is no shorter than min, or returns
+// ErrDurationAtLeast.
+func DurationAtLeast(min time.Duration) check.Step {
+	return OfDuration.AtLeast(min).Step()
+}
+
+// DurationAtMost returns a check.Step that verifies the target duration is no longer than max, or returns
+// ErrDurationAtMost.
+func DurationAtMost(max time.Duration) check.Step {
+	return OfDuration.AtMost(max).Step()
+}
+
+// DurationBetween returns a check.Step that verifies the target duration is from min to max inclusive, or returns
+// ErrDurationBetween.
+func DurationBetween(min time.Duration, max time.Duration) check.Step {
+	return OfDuration.Between(min, max).Step()
+}
@@ -0,0 +1,88 @@
+package timez_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/imulab/check/timez"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// monday is 10:30 on Monday, 2024-01-01.
+var monday = time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+func TestTime(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	cases := []struct {
+		name   string
+		target interface{}
+		step   check.Step
+		err    error
+	}{
+		{name: "not zero", target: monday, step: timez.NotZero},
+		{name: "zero", target: time.Time{}, step: timez.NotZero, err: timez.ErrNotZero},
+		{name: "before", target: monday, step: timez.Before(monday.Add(time.Nanosecond))},
+		{name: "not before", target: monday, step: timez.Before(monday), err: timez.ErrBefore},
+		{name: "after", target: monday, step: timez.After(monday.Add(-time.Nanosecond))},
+		{name: "not after", target: monday, step: timez.After(monday), err: timez.ErrAfter},
+		{name: "between start", target: monday, step: timez.Between(monday, monday.Add(time.Hour))},
+		{name: "between end", target: monday, step: timez.Between(monday.Add(-time.Hour), monday), err: timez.ErrBetween},
+		{name: "within", target: monday, step: timez.Within(monday.Add(time.Minute), time.Minute)},
+		{name: "within before", target: monday, step: timez.Within(monday.Add(-time.Minute), time.Minute)},
+		{name: "not within", target: monday, step: timez.Within(monday.Add(time.Minute+1), time.Minute), err: timez.ErrWithin},
+		{name: "same day", target: monday, step: timez.SameDay(monday.Add(13 * time.Hour))},
+		{name: "not same day", target: monday, step: timez.SameDay(monday.Add(14 * time.Hour)), err: timez.ErrSameDay},
+		{name: "other day in target location", target: monday.In(tokyo), step: timez.SameDay(monday.Add(14 * time.Hour)), err: timez.ErrSameDay},
+		{name: "same day in target location", target: monday.In(tokyo), step: timez.SameDay(monday.Add(-11 * time.Hour))},
+		{name: "weekday", target: monday, step: timez.Weekday(time.Sunday, time.Monday)},
+		{name: "not weekday", target: monday, step: timez.Weekday(time.Saturday, time.Sunday), err: timez.ErrWeekday},
+		{name: "time of day", target: monday, step: timez.TimeOfDay(9*time.Hour, 10*time.Hour+31*time.Minute)},
+		{name: "not time of day", target: monday, step: timez.TimeOfDay(9*time.Hour, 10*time.Hour+30*time.Minute), err: timez.ErrTimeOfDay},
+		{name: "overnight", target: monday.Add(13 * time.Hour), step: timez.TimeOfDay(22*time.Hour, 6*time.Hour)},
+		{name: "not overnight", target: monday, step: timez.TimeOfDay(22*time.Hour, 6*time.Hour), err: timez.ErrTimeOfDay},
+		{name: "business hours", target: monday, step: timez.BusinessHours(9*time.Hour, 17*time.Hour)},
+		{name: "after business hours", target: monday.Add(7 * time.Hour), step: timez.BusinessHours(9*time.Hour, 17*time.Hour), err: timez.ErrTimeOfDay},
+		{name: "weekend", target: monday.AddDate(0, 0, -1), step: timez.BusinessHours(9*time.Hour, 17*time.Hour), err: timez.ErrWeekday},
+		{name: "unexpected type", target: "2024-01-01", step: timez.NotZero, err: check.ErrUnexpectedType},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	cases := []struct {
+		name   string
+		target time.Duration
+		step   check.Step
+		err    error
+	}{
+		{name: "at least", target: time.Second, step: timez.DurationAtLeast(time.Second)},
+		{name: "not at least", target: time.Second - 1, step: timez.DurationAtLeast(time.Second), err: timez.ErrDurationAtLeast},
+		{name: "at most", target: time.Minute, step: timez.DurationAtMost(time.Minute)},
+		{name: "not at most", target: time.Minute + 1, step: timez.DurationAtMost(time.Minute), err: timez.ErrDurationAtMost},
+		{name: "between", target: time.Minute, step: timez.DurationBetween(time.Second, time.Minute)},
+		{name: "not between", target: 0, step: timez.DurationBetween(time.Second, time.Minute), err: timez.ErrDurationBetween},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := check.That(c.target, c.step)()
+			if c.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, c.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package check
+
+// StepOf is the type-safe counterpart of Step, which validates a target of type T. Passing a target of a
+// different type is a compile error, and named types (i.e. type Email string) can be validated without conversion.
+//
+// StepOf follows the same rules as Step: error returned aborts the remaining steps, and Skip skips them while
+// treating the validation run as successful.
+type StepOf[T any] func(target T) error
+
+// Typed adapts an untyped Step into a StepOf, so existing Step can be used with ThatOf.
+//
+//	check.ThatOf(email, check.Typed[Email](customStep))
+func Typed[T any](s Step) StepOf[T] {
+	return func(target T) error {
+		return s(target)
+	}
+}
+
+// Step adapts this StepOf into an untyped Step, so it can be used with That. The returned Step returns a TypeError
+// (which matches ErrUnexpectedType) if the target is not of type T.
+func (s StepOf[T]) Step() Step {
+	return func(target interface{}) error {
+		t, ok := target.(T)
+		if !ok {
+			return NewTypeError[T](target)
+		}
+		return s(t)
+	}
+}
+
+// Err is the typed version of Step.Err.
+func (s StepOf[T]) Err(err error) StepOf[T] {
+	return func(target T) error {
+		se := s(target)
+		switch se {
+		case nil:
+			return nil
+		case Skip:
+			return Skip
+		default:
+			return err
+		}
+	}
+}
+
+// If is the typed version of Step.If.
+func (s StepOf[T]) If(obj interface{}, condition Step) StepOf[T] {
+	return func(target T) error {
+		ce := condition(obj)
+		switch ce {
+		case nil:
+			return s(target)
+		default:
+			return nil
+		}
+	}
+}
+
+// When is the typed version of Step.When.
+func (s StepOf[T]) When(condition StepOf[T]) StepOf[T] {
+	return func(target T) error {
+		ce := condition(target)
+		switch ce {
+		case nil:
+			return s(target)
+		default:
+			return nil
+		}
+	}
+}
+
+// ThatOf is the typed version of That. All supplied validation StepOf are performed sequentially unless
+// an error is returned, or a StepOf returned Skip.
+//
+//	type Email string
+//	check.ThatOf(Email("foo@bar.com"), stringz.Of[Email]().Contains("@"))
+func ThatOf[T any](target T, steps ...StepOf[T]) ErrFunc {
+	return func() error {
+		for _, s := range steps {
+			if err := s(target); err != nil {
+				switch err {
+				case Skip:
+					return nil
+				default:
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
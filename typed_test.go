@@ -0,0 +1,56 @@
+package check_test
+
+import (
+	"errors"
+	"github.com/imulab/check"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type email string
+
+var (
+	correctEmailStep check.StepOf[email] = func(target email) error {
+		return nil
+	}
+	wrongEmailStep check.StepOf[email] = func(target email) error {
+		return errors.New("step has error")
+	}
+)
+
+func TestThatOf(t *testing.T) {
+	assert.NoError(t, check.ThatOf(email("foo@bar.com"), correctEmailStep)())
+	assert.Error(t, check.ThatOf(email("foo@bar.com"), correctEmailStep, wrongEmailStep)())
+	assert.NoError(t, check.ThatOf(email("foo@bar.com"), check.Typed[email](check.Optional), wrongEmailStep)())
+}
+
+func TestTyped(t *testing.T) {
+	assert.NoError(t, check.Typed[email](correctStep)("foo@bar.com"))
+	assert.Error(t, check.Typed[email](wrongStep)("foo@bar.com"))
+}
+
+func TestStepOf_Step(t *testing.T) {
+	assert.NoError(t, check.That(email("foo@bar.com"), correctEmailStep.Step())())
+	assert.Error(t, check.That(email("foo@bar.com"), wrongEmailStep.Step())())
+}
+
+func TestStepOf_When(t *testing.T) {
+	assert.Error(t, wrongEmailStep.When(correctEmailStep)("foo@bar.com"))
+	assert.NoError(t, wrongEmailStep.When(wrongEmailStep)("foo@bar.com"))
+}
+
+func TestStepOf_Err(t *testing.T) {
+	var customErr = errors.New("customErr")
+	assert.Equal(t, customErr, wrongEmailStep.Err(customErr)("foo@bar.com"))
+}
+
+func TestStepOf_Step_UnexpectedType(t *testing.T) {
+	err := check.That(42, correctEmailStep.Step())()
+	assert.True(t, errors.Is(err, check.ErrUnexpectedType))
+
+	var typeErr *check.TypeError
+	if assert.True(t, errors.As(err, &typeErr)) {
+		assert.Equal(t, "check_test.email", typeErr.Expected)
+		assert.Equal(t, "int", typeErr.Actual)
+	}
+}